@@ -0,0 +1,80 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+import "os"
+
+// ACLScope is the scope of an ACL entry.
+type ACLScope int
+
+// ACL entry scopes
+const (
+	// ACLAccess entries are checked when the file is accessed.
+	ACLAccess ACLScope = iota
+	// ACLDefault entries are inherited by the children of a directory.
+	ACLDefault
+)
+
+// ACLType is the type of an ACL entry.
+type ACLType int
+
+// ACL entry types
+const (
+	ACLUser ACLType = iota
+	ACLGroup
+	ACLMask
+	ACLOther
+)
+
+// ACLEntry is a single entry of a POSIX access control list.
+type ACLEntry struct {
+	Scope ACLScope
+	Type  ACLType
+
+	// Name is the user or group the entry applies to. An empty name means
+	// the file owner or the owning group.
+	Name string
+
+	// Perm holds the read (04), write (02) and execute (01) bits.
+	Perm os.FileMode
+}
+
+// ACLStatus describes the access control list of a file.
+type ACLStatus struct {
+	Owner  string
+	Group  string
+	Sticky bool
+
+	// Entries holds the extended ACL entries, that is, everything besides the
+	// owner, other and access mask entries, which are carried by Permission.
+	// It is empty if the file only has the base permissions.
+	Entries []ACLEntry
+
+	// Permission is the permission part of the file mode.
+	Permission os.FileMode
+}
+
+// ACL is implemented by the filesystems which support POSIX access control
+// lists.
+type ACL interface {
+	// GetACL returns the access control list of the named file.
+	GetACL(name string) (*ACLStatus, error)
+
+	// SetACL fully replaces the access control list of the named file. The
+	// owner, group and other entries are kept from the current permission
+	// if they are missing in entries, and the mask is computed when needed.
+	SetACL(name string, entries []ACLEntry) error
+
+	// ModifyACLEntries adds the given entries to the access control list of
+	// the named file, replacing existing entries of the same scope, type and
+	// name.
+	ModifyACLEntries(name string, entries []ACLEntry) error
+
+	// RemoveACL removes all the extended entries of the named file, keeping
+	// the base permission.
+	RemoveACL(name string) error
+}
@@ -0,0 +1,280 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package archive implements read-only filesystems over the content of a
+// zip or tar archive, itself stored on any filesystem. The archive is read
+// in place, its files are not extracted.
+package archive
+
+import (
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// maxSymlinks is the number of symbolic links followed to open a file.
+const maxSymlinks = 40
+
+// node is a file or a directory of the archive. The directories which have
+// no entry of their own are made up from the paths of their children.
+type node struct {
+	fi       os.FileInfo
+	target   string
+	children map[string]*node
+
+	// open returns the content of a regular file.
+	open func() (*file, error)
+}
+
+// archive is a read-only filesystem over the tree of the entries of an
+// archive.
+type archive struct {
+	file extfs.File
+	root *node
+	base string
+}
+
+func newArchive(f extfs.File, baseDir string) *archive {
+	return &archive{
+		file: f,
+		root: &node{fi: &dirInfo{name: "/"}, children: make(map[string]*node)},
+		base: baseDir,
+	}
+}
+
+// add adds an entry to the tree, with its missing parent directories. The
+// names are cleaned so that no entry is outside of the root.
+func (a *archive) add(name string, n *node) {
+	fullpath := path.Clean("/" + name)
+	if fullpath == "/" {
+		if n.fi.IsDir() {
+			a.root.fi = n.fi
+		}
+		return
+	}
+
+	parent := a.mkdirAll(path.Dir(fullpath))
+	base := path.Base(fullpath)
+	if existing, ok := parent.children[base]; ok && existing.children != nil && n.fi.IsDir() {
+		existing.fi = n.fi
+		return
+	}
+	if n.fi.IsDir() {
+		n.children = make(map[string]*node)
+	}
+	parent.children[base] = n
+}
+
+func (a *archive) mkdirAll(fullpath string) *node {
+	if fullpath == "/" {
+		return a.root
+	}
+
+	parent := a.mkdirAll(path.Dir(fullpath))
+	base := path.Base(fullpath)
+	n, ok := parent.children[base]
+	if !ok || n.children == nil {
+		n = &node{fi: &dirInfo{name: base}, children: make(map[string]*node)}
+		parent.children[base] = n
+	}
+
+	return n
+}
+
+// lookup returns the node of a path, following the symbolic links.
+func (a *archive) lookup(fullpath string) (*node, error) {
+	return a.walk(fullpath, 0)
+}
+
+func (a *archive) walk(fullpath string, links int) (*node, error) {
+	n := a.root
+	dir := "/"
+	for _, name := range strings.Split(strings.Trim(path.Clean("/"+fullpath), "/"), "/") {
+		if name == "" {
+			continue
+		}
+		if n.children == nil {
+			return nil, &os.PathError{Op: "stat", Path: fullpath, Err: syscall.ENOTDIR}
+		}
+		child, ok := n.children[name]
+		if !ok {
+			return nil, &os.PathError{Op: "stat", Path: fullpath, Err: os.ErrNotExist}
+		}
+
+		if child.fi.Mode()&os.ModeSymlink != 0 {
+			if links >= maxSymlinks {
+				return nil, &os.PathError{Op: "stat", Path: fullpath, Err: syscall.ELOOP}
+			}
+			target := child.target
+			if !path.IsAbs(target) {
+				target = path.Join(dir, target)
+			}
+			resolved, err := a.walk(target, links+1)
+			if err != nil {
+				return nil, err
+			}
+			child = resolved
+		}
+
+		n = child
+		dir = path.Join(dir, name)
+	}
+
+	return n, nil
+}
+
+func (a *archive) Create(filename string) (extfs.File, error) {
+	return nil, extfs.ErrReadOnly
+}
+
+func (a *archive) Open(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(a.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := a.lookup(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	if n.children != nil {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+	}
+
+	f, err := n.open()
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: err}
+	}
+	f.name = filename
+	f.fullpath = fullpath
+	f.fi = n.fi
+
+	return f, nil
+}
+
+func (a *archive) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_APPEND|os.O_CREATE|os.O_TRUNC) != 0 {
+		return nil, extfs.ErrReadOnly
+	}
+
+	return a.Open(filename)
+}
+
+func (a *archive) Remove(filename string) error {
+	return extfs.ErrReadOnly
+}
+
+func (a *archive) RemoveAll(path string) error {
+	return extfs.ErrReadOnly
+}
+
+func (a *archive) Rename(oldpath, newpath string) error {
+	return extfs.ErrReadOnly
+}
+
+func (a *archive) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(a.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := a.lookup(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The info of the target of a symbolic link has the name of the link.
+	if name := path.Base(fullpath); fullpath != "/" && n.fi.Name() != name {
+		return &renamedInfo{FileInfo: n.fi, name: name}, nil
+	}
+
+	return n.fi, nil
+}
+
+func (a *archive) ReadDir(dirname string) ([]os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(a.base, dirname)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := a.lookup(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	if n.children == nil {
+		return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: syscall.ENOTDIR}
+	}
+
+	fis := make([]os.FileInfo, 0, len(n.children))
+	for _, child := range n.children {
+		fis = append(fis, child.fi)
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+func (a *archive) MkdirAll(path string, perm os.FileMode) error {
+	return extfs.ErrReadOnly
+}
+
+func (a *archive) Chmod(name string, mode os.FileMode) error {
+	return extfs.ErrReadOnly
+}
+
+func (a *archive) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return extfs.ErrReadOnly
+}
+
+// Close closes the archive. The filesystem it is stored on stays open.
+func (a *archive) Close() error {
+	return a.file.Close()
+}
+
+// dirInfo describes a directory which has no entry in the archive.
+type dirInfo struct {
+	name string
+}
+
+func (fi *dirInfo) Name() string {
+	return fi.name
+}
+
+func (fi *dirInfo) Size() int64 {
+	return 0
+}
+
+func (fi *dirInfo) Mode() os.FileMode {
+	return os.ModeDir | 0555
+}
+
+func (fi *dirInfo) ModTime() time.Time {
+	return time.Time{}
+}
+
+func (fi *dirInfo) IsDir() bool {
+	return true
+}
+
+func (fi *dirInfo) Sys() interface{} {
+	return nil
+}
+
+// renamedInfo is the info of a file reached through a symbolic link.
+type renamedInfo struct {
+	os.FileInfo
+	name string
+}
+
+func (fi *renamedInfo) Name() string {
+	return fi.name
+}
@@ -0,0 +1,303 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/extfstest"
+	"github.com/rkcloudchain/extfs/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mtime = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// newStore returns a local filesystem in a temporary directory, where the
+// archives of the tests are written.
+func newStore(t *testing.T) extfs.Filesystem {
+	dir, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	return local.New(dir)
+}
+
+func zipArchive(t *testing.T) []byte {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+
+	add := func(name string, method uint16, mode os.FileMode, data string) {
+		hdr := &zip.FileHeader{Name: name, Method: method, Modified: mtime}
+		hdr.SetMode(mode)
+		fw, err := w.CreateHeader(hdr)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	add("docs/", zip.Store, os.ModeDir|0755, "")
+	add("docs/stored.txt", zip.Store, 0644, "Hello world")
+	add("docs/deflated.txt", zip.Deflate, 0644, "Hello compressed world")
+	add("data/nested/implicit.csv", zip.Deflate, 0600, "a,b\n1,2\n")
+	add("link.txt", zip.Store, os.ModeSymlink|0777, "docs/stored.txt")
+	add("docs/up", zip.Store, os.ModeSymlink|0777, "../data")
+	add("../escape.txt", zip.Store, 0644, "escaped")
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func tarArchive(t *testing.T, compressed bool) []byte {
+	buf := &bytes.Buffer{}
+	var out io.Writer = buf
+	var zw *gzip.Writer
+	if compressed {
+		zw = gzip.NewWriter(buf)
+		out = zw
+	}
+	w := tar.NewWriter(out)
+
+	add := func(hdr *tar.Header, data string) {
+		hdr.ModTime = mtime
+		hdr.Size = int64(len(data))
+		require.NoError(t, w.WriteHeader(hdr))
+		_, err := w.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	add(&tar.Header{Name: "docs/", Typeflag: tar.TypeDir, Mode: 0755}, "")
+	add(&tar.Header{Name: "docs/stored.txt", Typeflag: tar.TypeReg, Mode: 0644}, "Hello world")
+	add(&tar.Header{Name: "docs/deflated.txt", Typeflag: tar.TypeReg, Mode: 0644}, "Hello compressed world")
+	add(&tar.Header{Name: "data/nested/implicit.csv", Typeflag: tar.TypeReg, Mode: 0600}, "a,b\n1,2\n")
+	add(&tar.Header{Name: "link.txt", Typeflag: tar.TypeSymlink, Linkname: "docs/stored.txt", Mode: 0777}, "")
+	add(&tar.Header{Name: "docs/up", Typeflag: tar.TypeSymlink, Linkname: "../data", Mode: 0777}, "")
+	add(&tar.Header{Name: "hard.txt", Typeflag: tar.TypeLink, Linkname: "docs/deflated.txt"}, "")
+	add(&tar.Header{Name: "fifo", Typeflag: tar.TypeFifo, Mode: 0644}, "")
+	add(&tar.Header{Name: "../escape.txt", Typeflag: tar.TypeReg, Mode: 0644}, "escaped")
+	require.NoError(t, w.Close())
+	if compressed {
+		require.NoError(t, zw.Close())
+	}
+
+	return buf.Bytes()
+}
+
+// archives returns the filesystems over the same tree stored in the zip,
+// tar and tar.gz formats.
+func archives(t *testing.T) map[string]extfs.Filesystem {
+	store := newStore(t)
+	extfstest.WriteFile(t, store, "test.zip", zipArchive(t))
+	extfstest.WriteFile(t, store, "test.tar", tarArchive(t, false))
+	extfstest.WriteFile(t, store, "test.tar.gz", tarArchive(t, true))
+
+	zfs, err := NewZip(store, "test.zip", "/")
+	require.NoError(t, err)
+	tfs, err := NewTar(store, "test.tar", "/")
+	require.NoError(t, err)
+	gfs, err := NewTar(store, "test.tar.gz", "/")
+	require.NoError(t, err)
+
+	fss := map[string]extfs.Filesystem{"zip": zfs, "tar": tfs, "tar.gz": gfs}
+	t.Cleanup(func() {
+		for _, fs := range fss {
+			fs.Close()
+		}
+	})
+
+	return fss
+}
+
+func TestReadDir(t *testing.T) {
+	for format, fs := range archives(t) {
+		fis, err := fs.ReadDir("/")
+		require.NoError(t, err, format)
+		var names []string
+		for _, fi := range fis {
+			names = append(names, fi.Name())
+		}
+		expected := []string{"data", "docs", "escape.txt", "link.txt"}
+		if format != "zip" {
+			expected = []string{"data", "docs", "escape.txt", "hard.txt", "link.txt"}
+		}
+		assert.Equal(t, expected, names, format)
+
+		fis, err = fs.ReadDir("docs")
+		require.NoError(t, err, format)
+		require.Len(t, fis, 3, format)
+		assert.Equal(t, "deflated.txt", fis[0].Name())
+		assert.Equal(t, "stored.txt", fis[1].Name())
+		assert.Equal(t, "up", fis[2].Name())
+		assert.True(t, fis[2].Mode()&os.ModeSymlink != 0, format)
+
+		fis, err = fs.ReadDir("docs/up/nested")
+		require.NoError(t, err, format)
+		require.Len(t, fis, 1, format)
+		assert.Equal(t, "implicit.csv", fis[0].Name())
+
+		_, err = fs.ReadDir("docs/stored.txt")
+		assert.Equal(t, syscall.ENOTDIR, err.(*os.PathError).Err, format)
+		_, err = fs.ReadDir("missing")
+		assert.True(t, os.IsNotExist(err), format)
+	}
+}
+
+func TestStat(t *testing.T) {
+	for format, fs := range archives(t) {
+		fi, err := fs.Stat("docs/stored.txt")
+		require.NoError(t, err, format)
+		assert.Equal(t, "stored.txt", fi.Name())
+		assert.Equal(t, int64(11), fi.Size())
+		assert.Equal(t, os.FileMode(0644), fi.Mode(), format)
+		assert.True(t, mtime.Equal(fi.ModTime()), format)
+
+		fi, err = fs.Stat("data/nested")
+		require.NoError(t, err, format)
+		assert.True(t, fi.IsDir())
+
+		fi, err = fs.Stat("link.txt")
+		require.NoError(t, err, format)
+		assert.Equal(t, "link.txt", fi.Name())
+		assert.Equal(t, int64(11), fi.Size())
+
+		fi, err = fs.Stat("/")
+		require.NoError(t, err, format)
+		assert.True(t, fi.IsDir())
+
+		_, err = fs.Stat("docs/stored.txt/x")
+		assert.Equal(t, syscall.ENOTDIR, err.(*os.PathError).Err, format)
+		_, err = fs.Stat("../test.zip")
+		assert.Equal(t, extfs.ErrCrossedBoundary, err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	for format, fs := range archives(t) {
+		assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "docs/stored.txt")), format)
+		assert.Equal(t, "Hello compressed world", string(extfstest.ReadFile(t, fs, "docs/deflated.txt")), format)
+		assert.Equal(t, "a,b\n1,2\n", string(extfstest.ReadFile(t, fs, "docs/up/nested/implicit.csv")), format)
+		assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "link.txt")), format)
+		assert.Equal(t, "escaped", string(extfstest.ReadFile(t, fs, "escape.txt")), format)
+		if format != "zip" {
+			assert.Equal(t, "Hello compressed world", string(extfstest.ReadFile(t, fs, "hard.txt")), format)
+			_, err := fs.Stat("fifo")
+			assert.True(t, os.IsNotExist(err), format)
+		}
+
+		extfstest.TestRead(t, fs, "docs/stored.txt")
+
+		_, err := fs.Open("docs")
+		assert.Equal(t, syscall.EISDIR, err.(*os.PathError).Err, format)
+		_, err = fs.Open("missing.txt")
+		assert.True(t, os.IsNotExist(err), format)
+	}
+}
+
+func TestReadAt(t *testing.T) {
+	for format, fs := range archives(t) {
+		for _, name := range []string{"docs/stored.txt", "docs/deflated.txt"} {
+			f, err := fs.Open(name)
+			require.NoError(t, err, format)
+			size := int64(len(extfstest.ReadFile(t, fs, name)))
+
+			buf := make([]byte, 5)
+			_, err = io.ReadFull(f, buf)
+			require.NoError(t, err)
+			assert.Equal(t, "Hello", string(buf), format)
+
+			pos, err := f.Seek(-5, io.SeekEnd)
+			require.NoError(t, err)
+			assert.Equal(t, size-5, pos)
+			data, err := ioutil.ReadAll(f)
+			require.NoError(t, err)
+			assert.Equal(t, "world", string(data), format)
+
+			_, err = f.Seek(1, io.SeekStart)
+			require.NoError(t, err)
+			_, err = io.ReadFull(f, buf)
+			require.NoError(t, err)
+			assert.Equal(t, "ello ", string(buf), format)
+
+			buf = make([]byte, 3)
+			n, err := f.ReadAt(buf, 2)
+			require.NoError(t, err)
+			assert.Equal(t, "llo", string(buf[:n]), format)
+
+			buf = make([]byte, 10)
+			n, err = f.ReadAt(buf, size-5)
+			assert.Equal(t, io.EOF, err, format)
+			assert.Equal(t, "world", string(buf[:n]), format)
+
+			require.NoError(t, f.Close())
+			_, err = f.Read(buf)
+			assert.Error(t, err)
+		}
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	for format, fs := range archives(t) {
+		_, err := fs.Create("new.txt")
+		assert.Equal(t, extfs.ErrReadOnly, err, format)
+		_, err = fs.OpenFile("docs/stored.txt", os.O_WRONLY|os.O_TRUNC, 0)
+		assert.Equal(t, extfs.ErrReadOnly, err)
+		assert.Equal(t, extfs.ErrReadOnly, fs.Remove("docs/stored.txt"))
+		assert.Equal(t, extfs.ErrReadOnly, fs.RemoveAll("docs"))
+		assert.Equal(t, extfs.ErrReadOnly, fs.Rename("link.txt", "other.txt"))
+		assert.Equal(t, extfs.ErrReadOnly, fs.MkdirAll("dir", 0755))
+		assert.Equal(t, extfs.ErrReadOnly, fs.Chmod("docs/stored.txt", 0600))
+		assert.Equal(t, extfs.ErrReadOnly, fs.Chtimes("docs/stored.txt", time.Now(), time.Now()))
+
+		f, err := fs.OpenFile("docs/stored.txt", os.O_RDONLY, 0)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("x"))
+		assert.Equal(t, extfs.ErrReadOnly, err)
+		assert.Equal(t, extfs.ErrReadOnly, f.Truncate(0))
+		require.NoError(t, f.Close())
+	}
+}
+
+func TestBaseDir(t *testing.T) {
+	store := newStore(t)
+	extfstest.WriteFile(t, store, "test.tar", tarArchive(t, false))
+
+	fs, err := NewTar(store, "test.tar", "/docs")
+	require.NoError(t, err)
+	defer fs.Close()
+
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "stored.txt")))
+	_, err = fs.Stat("../link.txt")
+	assert.Equal(t, extfs.ErrCrossedBoundary, err)
+}
+
+func TestInvalidArchive(t *testing.T) {
+	store := newStore(t)
+	extfstest.WriteFile(t, store, "broken.zip", []byte("not an archive"))
+
+	_, err := NewZip(store, "broken.zip", "/")
+	assert.Error(t, err)
+	_, err = NewTar(store, "missing.tar", "/")
+	assert.True(t, os.IsNotExist(err))
+
+	extfstest.WriteFile(t, store, "dangling.tar", func() []byte {
+		buf := &bytes.Buffer{}
+		w := tar.NewWriter(buf)
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: "hard.txt", Typeflag: tar.TypeLink, Linkname: "missing.txt"}))
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}())
+	_, err = NewTar(store, "dangling.tar", "/")
+	assert.Error(t, err)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package archive
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+var errClosed = errors.New("archive file already closed")
+
+// file is a file of an archive opened for reading. Its content is either
+// read at random, or from a stream which is decompressed again to seek
+// backwards.
+type file struct {
+	name     string
+	fullpath string
+	fi       os.FileInfo
+	closed   bool
+	size     int64
+	offset   int64
+
+	// Random access files
+	ra io.ReaderAt
+
+	// Streams
+	openStream   func() (io.ReadCloser, error)
+	stream       io.ReadCloser
+	streamOffset int64
+}
+
+func newRandomAccessFile(ra io.ReaderAt, size int64) *file {
+	return &file{ra: ra, size: size}
+}
+
+func newStreamFile(open func() (io.ReadCloser, error), size int64) *file {
+	return &file{openStream: open, size: size}
+}
+
+func (f *file) Close() error {
+	if f.closed {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: errClosed}
+	}
+	f.closed = true
+
+	if f.stream != nil {
+		return f.stream.Close()
+	}
+
+	return nil
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if f.offset >= f.size {
+		return 0, io.EOF
+	}
+
+	if f.ra != nil {
+		n, err := f.ReadAt(p, f.offset)
+		f.offset += int64(n)
+		if err == io.EOF && n > 0 {
+			err = nil
+		}
+		return n, err
+	}
+
+	if err := f.seekStream(); err != nil {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: err}
+	}
+	n, err := f.stream.Read(p)
+	f.offset += int64(n)
+	f.streamOffset += int64(n)
+	if err == io.EOF && f.offset < f.size {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return n, err
+}
+
+func (f *file) ReadAt(p []byte, off int64) (int, error) {
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+	if off < 0 {
+		return 0, &os.PathError{Op: "readat", Path: f.fullpath, Err: errors.New("negative offset")}
+	}
+	if off >= f.size {
+		return 0, io.EOF
+	}
+
+	if f.ra != nil {
+		return f.ra.ReadAt(p, off)
+	}
+
+	stream, err := f.openStream()
+	if err != nil {
+		return 0, &os.PathError{Op: "readat", Path: f.fullpath, Err: err}
+	}
+	defer stream.Close()
+
+	if _, err := io.CopyN(ioutil.Discard, stream, off); err != nil {
+		return 0, &os.PathError{Op: "readat", Path: f.fullpath, Err: err}
+	}
+	n, err := io.ReadFull(stream, p)
+	if err == io.ErrUnexpectedEOF && off+int64(n) >= f.size {
+		err = io.EOF
+	}
+
+	return n, err
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	if f.closed {
+		return 0, &os.PathError{Op: "seek", Path: f.fullpath, Err: errClosed}
+	}
+
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = f.offset + offset
+	case io.SeekEnd:
+		abs = f.size + offset
+	default:
+		return f.offset, &os.PathError{Op: "seek", Path: f.fullpath, Err: os.ErrInvalid}
+	}
+	if abs < 0 {
+		return f.offset, &os.PathError{Op: "seek", Path: f.fullpath, Err: errors.New("negative position")}
+	}
+	f.offset = abs
+
+	return abs, nil
+}
+
+// seekStream moves the stream to the offset of the file. The stream is
+// opened again to go backwards, and read to go forwards.
+func (f *file) seekStream() error {
+	if f.stream != nil && f.streamOffset > f.offset {
+		f.stream.Close()
+		f.stream = nil
+	}
+	if f.stream == nil {
+		stream, err := f.openStream()
+		if err != nil {
+			return err
+		}
+		f.stream = stream
+		f.streamOffset = 0
+	}
+
+	n, err := io.CopyN(ioutil.Discard, f.stream, f.offset-f.streamOffset)
+	f.streamOffset += n
+	return err
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	return 0, extfs.ErrReadOnly
+}
+
+func (f *file) WriteAt(p []byte, off int64) (int, error) {
+	return 0, extfs.ErrReadOnly
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+func (f *file) Stat() (os.FileInfo, error) {
+	return f.fi, nil
+}
+
+func (f *file) Sync() error {
+	return nil
+}
+
+func (f *file) Truncate(size int64) error {
+	return extfs.ErrReadOnly
+}
@@ -0,0 +1,200 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+// readBufferSize is the size of the reads of the archive when an entry is
+// searched for.
+const readBufferSize = 1 << 20
+
+var errLinkTarget = errors.New("hard link to a missing file")
+
+// NewTar returns a read-only filesystem over a tar archive stored on fs,
+// either uncompressed or compressed with gzip. The archive is read through
+// once to list its entries. The files of an uncompressed archive are then
+// read at random from it. A compressed archive is decompressed again up to
+// a file to open it, and the file is buffered in memory.
+func NewTar(fs extfs.Filesystem, name, baseDir string) (extfs.Filesystem, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := readTar(f, baseDir)
+	if err != nil {
+		f.Close()
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	return a, nil
+}
+
+func readTar(f extfs.File, baseDir string) (*archive, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := fi.Size()
+
+	magic := make([]byte, 2)
+	_, err = f.ReadAt(magic, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	compressed := magic[0] == 0x1f && magic[1] == 0x8b
+
+	// The archive is read sequentially. The position in an uncompressed
+	// archive is the offset of the content of the entry just read.
+	pr := &positionReader{r: f}
+	var r io.Reader = pr
+	if compressed {
+		zr, err := gzip.NewReader(pr)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	a := newArchive(f, baseDir)
+	var links []string
+	tr := tar.NewReader(r)
+	for index := 0; ; index++ {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		n := &node{fi: hdr.FileInfo()}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+		case tar.TypeSymlink:
+			n.target = hdr.Linkname
+		case tar.TypeLink:
+			n.target = hdr.Linkname
+			links = append(links, hdr.Name)
+		case tar.TypeReg, tar.TypeRegA, tar.TypeGNUSparse:
+			if compressed || isSparse(hdr) {
+				n.open = tarBufferOpener(f, size, index, compressed)
+			} else {
+				section := io.NewSectionReader(f, pr.pos, hdr.Size)
+				n.open = func() (*file, error) {
+					return newRandomAccessFile(section, section.Size()), nil
+				}
+			}
+		default:
+			// The devices and the named pipes have no content.
+			continue
+		}
+		a.add(hdr.Name, n)
+	}
+
+	// The hard links share the content of their target, which may be
+	// anywhere in the archive.
+	for _, name := range links {
+		fullpath := path.Clean("/" + name)
+		link, err := a.lookup(fullpath)
+		if err != nil {
+			return nil, err
+		}
+		target, err := a.lookup(path.Clean("/" + link.target))
+		if err != nil || target.open == nil {
+			return nil, fmt.Errorf("%s: %v", name, errLinkTarget)
+		}
+		link.fi = &renamedInfo{FileInfo: target.fi, name: path.Base(fullpath)}
+		link.open = target.open
+	}
+
+	return a, nil
+}
+
+// tarBufferOpener returns the opener of the index-th entry of an archive
+// whose content cannot be read in place.
+func tarBufferOpener(ra io.ReaderAt, size int64, index int, compressed bool) func() (*file, error) {
+	return func() (*file, error) {
+		var r io.Reader = bufio.NewReaderSize(io.NewSectionReader(ra, 0, size), readBufferSize)
+		if compressed {
+			zr, err := gzip.NewReader(r)
+			if err != nil {
+				return nil, err
+			}
+			defer zr.Close()
+			r = zr
+		}
+
+		tr := tar.NewReader(r)
+		for i := 0; i <= index; i++ {
+			if _, err := tr.Next(); err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return nil, err
+			}
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		return newRandomAccessFile(bytes.NewReader(data), int64(len(data))), nil
+	}
+}
+
+// isSparse reports whether the content of an entry is stored with holes,
+// so that it is not a contiguous section of the archive.
+func isSparse(hdr *tar.Header) bool {
+	if hdr.Typeflag == tar.TypeGNUSparse {
+		return true
+	}
+	for key := range hdr.PAXRecords {
+		if strings.HasPrefix(key, "GNU.sparse.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// positionReader counts the bytes read and skipped by the tar reader.
+type positionReader struct {
+	r   io.ReadSeeker
+	pos int64
+}
+
+func (r *positionReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+func (r *positionReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := r.r.Seek(offset, whence)
+	if err == nil {
+		r.pos = pos
+	}
+
+	return pos, err
+}
@@ -0,0 +1,98 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package archive
+
+import (
+	"archive/zip"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+// maxSymlinkSize bounds the content of the entries read as the target of a
+// symbolic link.
+const maxSymlinkSize = 4096
+
+// NewZip returns a read-only filesystem over a zip archive stored on fs.
+// The central directory is read once, then the stored files are read at
+// random from the archive and the compressed ones are decompressed as
+// they are read.
+func NewZip(fs extfs.Filesystem, name, baseDir string) (extfs.Filesystem, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := readZip(f, baseDir)
+	if err != nil {
+		f.Close()
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	return a, nil
+}
+
+func readZip(f extfs.File, baseDir string) (*archive, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := zip.NewReader(f, fi.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	a := newArchive(f, baseDir)
+	for _, zf := range r.File {
+		n := &node{fi: zf.FileInfo()}
+		switch {
+		case n.fi.IsDir():
+		case n.fi.Mode()&os.ModeSymlink != 0:
+			n.target, err = readSymlink(zf)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			n.open = zipOpener(f, zf)
+		}
+		a.add(zf.Name, n)
+	}
+
+	return a, nil
+}
+
+// zipOpener returns the opener of a file of the archive. The content of
+// the stored files is a section of the archive.
+func zipOpener(ra io.ReaderAt, zf *zip.File) func() (*file, error) {
+	return func() (*file, error) {
+		size := int64(zf.UncompressedSize64)
+		if zf.Method == zip.Store {
+			offset, err := zf.DataOffset()
+			if err != nil {
+				return nil, err
+			}
+			return newRandomAccessFile(io.NewSectionReader(ra, offset, size), size), nil
+		}
+
+		open := func() (io.ReadCloser, error) { return zf.Open() }
+		return newStreamFile(open, size), nil
+	}
+}
+
+func readSymlink(zf *zip.File) (string, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	target, err := ioutil.ReadAll(io.LimitReader(rc, maxSymlinkSize))
+	return string(target), err
+}
@@ -0,0 +1,112 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package azure implements filesystems over the Azure storage services.
+//
+// NewDataLake uses the Data Lake Storage Gen2 service of the accounts with
+// a hierarchical namespace, which has real directories, atomic renames and
+// appends, and POSIX permissions and ownership.
+//
+// NewBlob uses the Blob service, where the directories are emulated with
+// the prefixes of the blob names like on S3. MkdirAll stores an empty blob
+// with the hdi_isfolder metadata for each directory, like the hadoop WASB
+// driver, so that empty directories are kept. Renames copy and delete the
+// blobs, so renaming a directory is neither atomic nor cheap.
+package azure
+
+import (
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+// newClient returns the client of a container of the account of the
+// endpoint.
+func newClient(endpoint, container string, cfg *extfs.Config) (*client, error) {
+	if container == "" {
+		return nil, errors.New("Azure container name is empty")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("Invalid Azure endpoint " + endpoint)
+	}
+
+	c := &client{endpoint: u, container: container}
+	if accountKey := firstNonEmpty(cfg.AzureAccountKey, os.Getenv("AZURE_STORAGE_KEY")); accountKey != "" {
+		key, err := base64.StdEncoding.DecodeString(accountKey)
+		if err != nil {
+			return nil, errors.New("Invalid Azure account key: " + err.Error())
+		}
+		c.key = &sharedKey{account: accountName(u), key: key}
+	}
+	if token := firstNonEmpty(cfg.AzureSASToken, os.Getenv("AZURE_STORAGE_SAS_TOKEN")); token != "" {
+		c.sas, err = url.ParseQuery(strings.TrimPrefix(token, "?"))
+		if err != nil {
+			return nil, errors.New("Invalid Azure SAS token: " + err.Error())
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout}
+	c.http = &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           dialer.DialContext,
+			TLSClientConfig:       cfg.TLSConfig,
+			ResponseHeaderTimeout: cfg.RPCTimeout,
+		},
+	}
+
+	return c, nil
+}
+
+// accountName returns the name of the storage account of an endpoint. It
+// is the first segment of the path of the emulators, such as
+// http://127.0.0.1:10000/devstoreaccount1, and the first label of the host
+// name of the service, such as account.blob.core.windows.net.
+func accountName(u *url.URL) string {
+	if p := strings.Trim(u.Path, "/"); p != "" {
+		return strings.SplitN(p, "/", 2)[0]
+	}
+
+	return strings.SplitN(u.Hostname(), ".", 2)[0]
+}
+
+// pathKey returns the name of the blob or the path of a full path, which
+// is empty for the root.
+func pathKey(fullpath string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(fullpath)), "/")
+}
+
+// dirKey returns the prefix of the names of the content of a directory.
+func dirKey(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	return key + "/"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
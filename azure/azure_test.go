@@ -0,0 +1,405 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/azure/azuretest"
+	"github.com/rkcloudchain/extfs/extfstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAccount    = "devstoreaccount1"
+	testAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	testContainer  = "cloudchain"
+)
+
+var (
+	dfsServer  *azuretest.Server
+	blobServer *azuretest.Server
+)
+
+func TestMain(m *testing.M) {
+	extfstest.Main(m, func() (func(), error) {
+		var err error
+		dfsServer, err = azuretest.NewServer(testAccount, testAccountKey, true)
+		if err != nil {
+			return nil, err
+		}
+		blobServer, err = azuretest.NewServer(testAccount, testAccountKey, false)
+		if err != nil {
+			dfsServer.Close()
+			return nil, err
+		}
+		dfsServer.CreateContainer(testContainer)
+		blobServer.CreateContainer(testContainer)
+
+		return func() {
+			dfsServer.Close()
+			blobServer.Close()
+		}, nil
+	})
+}
+
+func newDataLake(t *testing.T, base string) extfs.Filesystem {
+	fs, err := NewDataLake(dfsServer.URL(), testContainer, base, &extfs.Config{AzureAccountKey: testAccountKey})
+	require.NoError(t, err)
+
+	return fs
+}
+
+func newBlob(t *testing.T, base string) extfs.Filesystem {
+	fs, err := NewBlob(blobServer.URL(), testContainer, base, &extfs.Config{AzureAccountKey: testAccountKey})
+	require.NoError(t, err)
+
+	return fs
+}
+
+// forEachService runs a test on a Data Lake filesystem and on a blob
+// filesystem.
+func forEachService(t *testing.T, base string, test func(t *testing.T, fs extfs.Filesystem, server *azuretest.Server)) {
+	t.Run("datalake", func(t *testing.T) {
+		fs := newDataLake(t, base)
+		defer fs.Close()
+		test(t, fs, dfsServer)
+	})
+	t.Run("blob", func(t *testing.T) {
+		fs := newBlob(t, base)
+		defer fs.Close()
+		test(t, fs, blobServer)
+	})
+}
+
+// TestFilesystem runs the shared checks on both services. Only the files of
+// the Data Lake service can be appended.
+func TestFilesystem(t *testing.T) {
+	t.Run("datalake", func(t *testing.T) {
+		extfstest.Test(t, extfstest.Config{New: newDataLake, Append: true})
+	})
+	t.Run("blob", func(t *testing.T) {
+		extfstest.Test(t, extfstest.Config{New: newBlob})
+	})
+}
+
+func TestCreate(t *testing.T) {
+	forEachService(t, "/test1", func(t *testing.T, fs extfs.Filesystem, server *azuretest.Server) {
+		extfstest.WriteFile(t, fs, "dir/my file+1.txt", []byte("Hello world"))
+		assert.Contains(t, server.Paths(testContainer), "test1/dir/my file+1.txt")
+
+		fi, err := fs.Stat("dir/my file+1.txt")
+		require.NoError(t, err)
+		assert.False(t, fi.ModTime().IsZero())
+		assert.Equal(t, "test1/dir/my file+1.txt", fi.Sys().(*Properties).Name)
+	})
+}
+
+func TestBlocks(t *testing.T) {
+	defer func(size int) { blockSize = size }(blockSize)
+	blockSize = 1024
+
+	data := bytes.Repeat([]byte("0123456789"), 300)
+	write := func(t *testing.T, fs extfs.Filesystem) {
+		f, err := fs.Create("large.bin")
+		require.NoError(t, err)
+		for i := 0; i < len(data); i += 100 {
+			_, err = f.Write(data[i : i+100])
+			require.NoError(t, err)
+		}
+		require.NoError(t, f.Close())
+		assert.True(t, bytes.Equal(data, extfstest.ReadFile(t, fs, "large.bin")))
+	}
+
+	t.Run("datalake", func(t *testing.T) {
+		fs := newDataLake(t, "/test2")
+		defer fs.Close()
+
+		appends, flushes := dfsServer.Requests("AppendData"), dfsServer.Requests("FlushData")
+		write(t, fs)
+		assert.Equal(t, appends+3, dfsServer.Requests("AppendData"))
+		assert.Equal(t, flushes+1, dfsServer.Requests("FlushData"))
+	})
+
+	t.Run("blob", func(t *testing.T) {
+		fs := newBlob(t, "/test2")
+		defer fs.Close()
+
+		blocks, puts := blobServer.Requests("PutBlock"), blobServer.Requests("PutBlob")
+		write(t, fs)
+		assert.Equal(t, blocks+3, blobServer.Requests("PutBlock"))
+		assert.Equal(t, puts, blobServer.Requests("PutBlob"))
+
+		// A small blob is put in one request.
+		extfstest.WriteFile(t, fs, "small.txt", []byte("Hello"))
+		assert.Equal(t, puts+1, blobServer.Requests("PutBlob"))
+	})
+}
+
+func TestOpenFile(t *testing.T) {
+	forEachService(t, "/test4", func(t *testing.T, fs extfs.Filesystem, server *azuretest.Server) {
+		f, err := fs.OpenFile("myfile.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		require.NoError(t, err)
+		_, err = f.WriteAt([]byte("x"), 0)
+		assert.Equal(t, extfs.ErrUnsupported, err)
+		_, err = f.Write([]byte("Hello"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		_, err = fs.OpenFile("myfile.txt", os.O_WRONLY, 0644)
+		assert.Error(t, err)
+
+		_, err = fs.OpenFile("myfile.txt", os.O_RDWR, 0644)
+		assert.Error(t, err)
+
+		_, err = fs.Open("")
+		assert.True(t, errors.Is(err, syscall.EISDIR))
+
+		err = fs.Chtimes("myfile.txt", time.Now(), time.Now())
+		assert.True(t, errors.Is(err, extfs.ErrUnsupported))
+	})
+}
+
+func TestDirectories(t *testing.T) {
+	forEachService(t, "/test6", func(t *testing.T, fs extfs.Filesystem, server *azuretest.Server) {
+		require.NoError(t, fs.MkdirAll("a/empty", 0755))
+		require.NoError(t, fs.MkdirAll("a/empty", 0755))
+		extfstest.WriteFile(t, fs, "a/file.txt", []byte("Hello"))
+		extfstest.WriteFile(t, fs, "a/b/c/deep.txt", []byte("world"))
+
+		fis, err := fs.ReadDir("a/empty")
+		require.NoError(t, err)
+		assert.Empty(t, fis)
+
+		_, err = fs.ReadDir("a/file.txt")
+		assert.True(t, errors.Is(err, syscall.ENOTDIR))
+
+		err = fs.MkdirAll("a/file.txt/sub", 0755)
+		assert.True(t, errors.Is(err, syscall.ENOTDIR))
+		err = fs.MkdirAll("a/file.txt", 0755)
+		assert.True(t, errors.Is(err, syscall.ENOTDIR))
+
+		err = fs.Remove("a")
+		assert.True(t, errors.Is(err, syscall.ENOTEMPTY))
+		require.NoError(t, fs.Remove("a/empty"))
+		_, err = fs.Stat("a/empty")
+		assert.True(t, os.IsNotExist(err))
+
+		// The directory of the last removed file is kept.
+		require.NoError(t, fs.Remove("a/b/c/deep.txt"))
+		fi, err := fs.Stat("a/b/c")
+		require.NoError(t, err)
+		assert.True(t, fi.IsDir())
+	})
+}
+
+func TestManyPaths(t *testing.T) {
+	forEachService(t, "/test7", func(t *testing.T, fs extfs.Filesystem, server *azuretest.Server) {
+		server.SetMaxResults(2)
+		defer server.SetMaxResults(5000)
+
+		require.NoError(t, fs.MkdirAll("many/sub", 0755))
+		for i := 0; i < 5; i++ {
+			extfstest.WriteFile(t, fs, fmt.Sprintf("many/%d.txt", i), []byte("x"))
+		}
+
+		fis, err := fs.ReadDir("many")
+		require.NoError(t, err)
+		require.Len(t, fis, 6)
+		assert.Equal(t, "4.txt", fis[4].Name())
+		assert.Equal(t, "sub", fis[5].Name())
+		assert.True(t, fis[5].IsDir())
+
+		require.NoError(t, fs.RemoveAll("many"))
+		_, err = fs.Stat("many")
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestRename(t *testing.T) {
+	forEachService(t, "/test8", func(t *testing.T, fs extfs.Filesystem, server *azuretest.Server) {
+		extfstest.WriteFile(t, fs, "src/renamed.txt", []byte("Hello"))
+		extfstest.WriteFile(t, fs, "src/sub/file2.txt", []byte("world"))
+		require.NoError(t, fs.MkdirAll("src/empty", 0755))
+
+		// An empty destination directory is replaced.
+		require.NoError(t, fs.MkdirAll("dst", 0755))
+		require.NoError(t, fs.Rename("src", "dst"))
+		assert.Equal(t, "Hello", string(extfstest.ReadFile(t, fs, "dst/renamed.txt")))
+		assert.Equal(t, "world", string(extfstest.ReadFile(t, fs, "dst/sub/file2.txt")))
+		fi, err := fs.Stat("dst/empty")
+		require.NoError(t, err)
+		assert.True(t, fi.IsDir())
+		_, err = fs.Stat("src")
+		assert.True(t, os.IsNotExist(err))
+
+		require.NoError(t, fs.Rename("dst/renamed.txt", "other/dir/moved.txt"))
+		assert.Equal(t, "Hello", string(extfstest.ReadFile(t, fs, "other/dir/moved.txt")))
+
+		err = fs.Rename("dst", "dst/sub/inside")
+		assert.True(t, errors.Is(err, os.ErrInvalid))
+		err = fs.Rename("other/dir/moved.txt", "dst")
+		assert.True(t, errors.Is(err, syscall.EISDIR))
+		err = fs.Rename("dst", "other/dir/moved.txt")
+		assert.True(t, errors.Is(err, syscall.ENOTDIR))
+		err = fs.Rename("other", "dst")
+		assert.True(t, errors.Is(err, syscall.ENOTEMPTY))
+	})
+}
+
+// TestAtomicRename checks that a directory of the Data Lake service is
+// renamed in one request, while its blobs are copied one by one.
+func TestAtomicRename(t *testing.T) {
+	fs := newDataLake(t, "/test9")
+	defer fs.Close()
+
+	for i := 0; i < 3; i++ {
+		extfstest.WriteFile(t, fs, fmt.Sprintf("src/%d.txt", i), []byte("x"))
+	}
+	renames := dfsServer.Requests("RenamePath")
+	require.NoError(t, fs.Rename("src", "dst"))
+	assert.Equal(t, renames+1, dfsServer.Requests("RenamePath"))
+	assert.Contains(t, dfsServer.Paths(testContainer), "test9/dst/2.txt")
+
+	blob := newBlob(t, "/test9")
+	defer blob.Close()
+
+	for i := 0; i < 3; i++ {
+		extfstest.WriteFile(t, blob, fmt.Sprintf("src/%d.txt", i), []byte("x"))
+	}
+	copies := blobServer.Requests("CopyBlob")
+	require.NoError(t, blob.Rename("src", "dst"))
+	assert.Equal(t, copies+3, blobServer.Requests("CopyBlob"))
+	assert.Contains(t, blobServer.Paths(testContainer), "test9/dst/2.txt")
+}
+
+func TestAccessControl(t *testing.T) {
+	fs := newDataLake(t, "/test10")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello"))
+	fi, err := fs.Stat("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), fi.Mode())
+
+	require.NoError(t, fs.Chmod("myfile.txt", 0600))
+	fi, err = fs.Stat("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode())
+	assert.Equal(t, "rw-------", fi.Sys().(*Properties).Permissions)
+
+	require.NoError(t, fs.MkdirAll("dir", 0755))
+	require.NoError(t, fs.Chmod("dir", os.ModeSticky|0777))
+	fi, err = fs.Stat("dir")
+	require.NoError(t, err)
+	assert.Equal(t, os.ModeDir|os.ModeSticky|0777, fi.Mode())
+
+	chowner := fs.(extfs.Chowner)
+	user, group, err := chowner.Owner("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "$superuser", user)
+	assert.Equal(t, "$superuser", group)
+
+	require.NoError(t, chowner.Chown("myfile.txt", "alice", "analysts"))
+	require.NoError(t, chowner.Chown("myfile.txt", "", "staff"))
+	user, group, err = chowner.Owner("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user)
+	assert.Equal(t, "staff", group)
+
+	err = fs.Chmod("missing", 0600)
+	assert.True(t, os.IsNotExist(err))
+
+	blob := newBlob(t, "/test10")
+	defer blob.Close()
+
+	extfstest.WriteFile(t, blob, "myfile.txt", []byte("Hello"))
+	err = blob.Chmod("myfile.txt", 0600)
+	assert.True(t, errors.Is(err, extfs.ErrUnsupported))
+	_, ok := blob.(extfs.Chowner)
+	assert.False(t, ok)
+}
+
+func TestCredentials(t *testing.T) {
+	wrongKey := "d3Jvbmc="
+	for _, server := range []*azuretest.Server{dfsServer, blobServer} {
+		fs, err := NewDataLake(server.URL(), testContainer, "/", &extfs.Config{AzureAccountKey: wrongKey})
+		if server == blobServer {
+			fs, err = NewBlob(server.URL(), testContainer, "/", &extfs.Config{AzureAccountKey: wrongKey})
+		}
+		require.NoError(t, err)
+
+		_, err = fs.Stat("myfile.txt")
+		assert.True(t, os.IsPermission(err))
+		_, err = fs.ReadDir("")
+		assert.True(t, os.IsPermission(err))
+		fs.Close()
+
+		_, err = NewBlob(server.URL(), testContainer, "/", &extfs.Config{AzureAccountKey: "not base64"})
+		assert.Error(t, err)
+	}
+
+	fs, err := NewDataLake(dfsServer.URL(), testContainer, "/test11", &extfs.Config{AzureSASToken: "?" + dfsServer.SASToken()})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "src.txt", []byte("Hello"))
+	require.NoError(t, fs.Rename("src.txt", "dst.txt"))
+	assert.Equal(t, "Hello", string(extfstest.ReadFile(t, fs, "dst.txt")))
+
+	blob, err := NewBlob(blobServer.URL(), testContainer, "/test11", &extfs.Config{AzureSASToken: blobServer.SASToken()})
+	require.NoError(t, err)
+	defer blob.Close()
+
+	extfstest.WriteFile(t, blob, "src.txt", []byte("Hello"))
+	require.NoError(t, blob.Rename("src.txt", "dst.txt"))
+	assert.Equal(t, "Hello", string(extfstest.ReadFile(t, blob, "dst.txt")))
+
+	fs, err = NewDataLake(dfsServer.URL(), "missing", "/", &extfs.Config{AzureAccountKey: testAccountKey})
+	require.NoError(t, err)
+	_, err = fs.ReadDir("")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestURL(t *testing.T) {
+	c, err := newClient("https://account.blob.core.windows.net", "container", &extfs.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://account.blob.core.windows.net/container/dir/a%20b%2Bc.txt", c.url("dir/a b+c.txt", nil).String())
+	assert.Equal(t, "https://account.blob.core.windows.net/container/", c.url("/", nil).String())
+
+	assert.Equal(t, "account", accountName(c.endpoint))
+	c, err = newClient("http://127.0.0.1:10000/devstoreaccount1", "container", &extfs.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "devstoreaccount1", accountName(c.endpoint))
+
+	_, err = newClient("ftp://account.blob.core.windows.net", "container", &extfs.Config{})
+	assert.Error(t, err)
+	_, err = newClient("https://account.blob.core.windows.net", "", &extfs.Config{})
+	assert.Error(t, err)
+}
+
+// TestSign checks the signature of a request against one computed with the
+// Shared Key algorithm of the Azure documentation.
+func TestSign(t *testing.T) {
+	key := &sharedKey{account: "myaccount", key: []byte("secret")}
+	req, err := http.NewRequest(http.MethodGet, "https://myaccount.blob.core.windows.net/mycontainer?restype=container&comp=metadata", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Ms-Date", "Fri, 26 Jun 2015 23:39:12 GMT")
+	req.Header.Set("X-Ms-Version", "2015-02-21")
+	key.sign(req)
+
+	assert.Equal(t, "SharedKey myaccount:725zYV+j1r1yx+11VwbuyeDbmFdCo/RcIs9WGw+0IS0=", req.Header.Get("Authorization"))
+}
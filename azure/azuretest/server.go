@@ -0,0 +1,1064 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package azuretest provides an in-process Azure storage server for the
+// tests. It keeps the containers in memory and serves the requests of one
+// account with path-style addressing, like the emulators do, such as
+// http://127.0.0.1:10000/account/container/name.
+//
+// A server with a hierarchical namespace implements the path operations of
+// the Data Lake Storage Gen2 service used by the azure package, other
+// servers the blob operations of the Blob service. When it has a key it
+// checks the Shared Key signature of the requests, or their shared access
+// signature.
+package azuretest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxResults  = 5000
+	defaultOwner       = "$superuser"
+	defaultFilePerm    = "rw-r-----"
+	defaultDirPerm     = "rwxr-x---"
+	sasSignature       = "azuretest"
+	headerErrorCode    = "X-Ms-Error-Code"
+	headerContinuation = "X-Ms-Continuation"
+)
+
+// entry is a blob, or a file or a directory of a hierarchical namespace.
+type entry struct {
+	data     []byte
+	dir      bool
+	metadata map[string]string
+	modTime  time.Time
+	etag     string
+
+	// Data Lake only
+	pending     []byte
+	owner       string
+	group       string
+	permissions string
+}
+
+type container struct {
+	entries map[string]*entry
+
+	// blocks are the uncommitted blocks of the blobs by their ID.
+	blocks map[string]map[string][]byte
+
+	// root holds the access control of the root directory of a
+	// hierarchical namespace.
+	root *entry
+}
+
+// Server is an in-process Azure storage server.
+type Server struct {
+	srv *httptest.Server
+
+	account      string
+	key          []byte
+	hierarchical bool
+
+	mu         sync.Mutex
+	containers map[string]*container
+	maxResults int
+	nextETag   int
+	requests   map[string]int
+}
+
+// NewServer starts a server for an account. If key is not empty, it is the
+// base64 encoded account key the requests must be signed with. The server
+// has a hierarchical namespace if hierarchical is true.
+func NewServer(account, key string, hierarchical bool) (*Server, error) {
+	s := &Server{
+		account:      account,
+		hierarchical: hierarchical,
+		containers:   make(map[string]*container),
+		maxResults:   defaultMaxResults,
+		requests:     make(map[string]int),
+	}
+	if key != "" {
+		var err error
+		if s.key, err = base64.StdEncoding.DecodeString(key); err != nil {
+			return nil, err
+		}
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s, nil
+}
+
+// URL returns the endpoint of the account, such as
+// http://127.0.0.1:10000/account.
+func (s *Server) URL() string {
+	return s.srv.URL + "/" + s.account
+}
+
+// SASToken returns a shared access signature the server accepts instead of
+// the Shared Key signature.
+func (s *Server) SASToken() string {
+	return "sv=2019-12-12&ss=b&srt=sco&sp=rwdlac&sig=" + sasSignature
+}
+
+// CreateContainer creates an empty container, which the Data Lake service
+// calls a filesystem.
+func (s *Server) CreateContainer(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.containers[name] == nil {
+		s.containers[name] = &container{
+			entries: make(map[string]*entry),
+			blocks:  make(map[string]map[string][]byte),
+			root:    &entry{dir: true, owner: defaultOwner, group: defaultOwner, permissions: defaultDirPerm},
+		}
+	}
+}
+
+// Paths returns the sorted names of the blobs or the paths of a container.
+// The directories of a hierarchical namespace end with a slash.
+func (s *Server) Paths(name string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.containers[name]
+	if c == nil {
+		return nil
+	}
+
+	paths := make([]string, 0, len(c.entries))
+	for p, e := range c.entries {
+		if s.hierarchical && e.dir {
+			p += "/"
+		}
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// SetMaxResults sets the size of the pages of the listings, which is 5000
+// by default.
+func (s *Server) SetMaxResults(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.maxResults = n
+}
+
+// Requests returns the number of requests served for an operation, named
+// like in the REST API, such as PutBlock or RenamePath.
+func (s *Server) Requests(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[operation]
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	s.srv.Close()
+	return nil
+}
+
+// storageError is an error response of the service.
+type storageError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *storageError) Error() string {
+	return e.code + ": " + e.message
+}
+
+var (
+	errContainerNotFound = &storageError{http.StatusNotFound, "ContainerNotFound", "The specified container does not exist."}
+	errBlobNotFound      = &storageError{http.StatusNotFound, "BlobNotFound", "The specified blob does not exist."}
+	errBlobExists        = &storageError{http.StatusConflict, "BlobAlreadyExists", "The specified blob already exists."}
+	errPathNotFound      = &storageError{http.StatusNotFound, "PathNotFound", "The specified path does not exist."}
+	errPathExists        = &storageError{http.StatusConflict, "PathAlreadyExists", "The specified path already exists."}
+	errPathConflict      = &storageError{http.StatusConflict, "PathConflict", "The specified path, or an element of the path, exists and its resource type is invalid for this operation."}
+	errDirNotEmpty       = &storageError{http.StatusConflict, "DirectoryNotEmpty", "The recursive query parameter value must be true to delete a non-empty directory."}
+	errInvalidRange      = &storageError{http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The range specified is invalid for the current size of the resource."}
+	errInvalidPosition   = &storageError{http.StatusBadRequest, "InvalidFlushPosition", "The uploaded data is not contiguous or the position query parameter value is not equal to the length of the file after appending the uploaded data."}
+	errNotImplemented    = &storageError{http.StatusNotImplemented, "NotImplemented", "The requested operation is not implemented."}
+)
+
+func invalidInput(message string) error {
+	return &storageError{http.StatusBadRequest, "InvalidInput", message}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, r, invalidInput(err.Error()))
+		return
+	}
+	if err := s.authenticate(r); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	// Path-style addressing: /account/container/name
+	p := strings.TrimPrefix(r.URL.Path, "/"+s.account+"/")
+	if p == r.URL.Path {
+		s.writeError(w, r, &storageError{http.StatusBadRequest, "InvalidUri", "The requested URI does not represent any resource on the server."})
+		return
+	}
+	name, key := p, ""
+	if i := strings.Index(p, "/"); i >= 0 {
+		name, key = p[:i], strings.Trim(p[i+1:], "/")
+	}
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.containers[name]
+	if c == nil {
+		if s.hierarchical {
+			s.writeError(w, r, &storageError{http.StatusNotFound, "FilesystemNotFound", "The specified filesystem does not exist."})
+		} else {
+			s.writeError(w, r, errContainerNotFound)
+		}
+		return
+	}
+
+	var op string
+	if s.hierarchical {
+		op, err = s.servePath(w, r, c, key, query, body)
+	} else {
+		op, err = s.serveBlob(w, r, c, key, query, body)
+	}
+	s.requests[op]++
+	if err != nil {
+		s.writeError(w, r, err)
+	}
+}
+
+func (s *Server) serveBlob(w http.ResponseWriter, r *http.Request, c *container, key string, query url.Values, body []byte) (op string, err error) {
+	switch {
+	case key == "" && r.Method == http.MethodGet && query.Get("comp") == "list":
+		op, err = "ListBlobs", s.listBlobs(w, c, query)
+	case key == "":
+		op, err = "", errNotImplemented
+	case r.Method == http.MethodHead:
+		op, err = "GetBlobProperties", s.getBlob(w, r, c, key, false)
+	case r.Method == http.MethodGet:
+		op, err = "GetBlob", s.getBlob(w, r, c, key, true)
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		op, err = "PutBlock", s.putBlock(w, c, key, query, body)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		op, err = "PutBlockList", s.putBlockList(w, r, c, key, body)
+	case r.Method == http.MethodPut && r.Header.Get("X-Ms-Copy-Source") != "":
+		op, err = "CopyBlob", s.copyBlob(w, r, c, key)
+	case r.Method == http.MethodPut:
+		op, err = "PutBlob", s.putBlob(w, r, c, key, body)
+	case r.Method == http.MethodDelete:
+		op = "DeleteBlob"
+		if c.entries[key] == nil {
+			return op, errBlobNotFound
+		}
+		delete(c.entries, key)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		op, err = "", errNotImplemented
+	}
+
+	return op, err
+}
+
+func (s *Server) servePath(w http.ResponseWriter, r *http.Request, c *container, key string, query url.Values, body []byte) (op string, err error) {
+	switch {
+	case key == "" && r.Method == http.MethodGet && query.Get("resource") == "filesystem":
+		op, err = "ListPaths", s.listPaths(w, c, query)
+	case r.Method == http.MethodPatch && query.Get("action") == "setAccessControl":
+		op, err = "SetAccessControl", s.setAccessControl(w, r, c, key)
+	case key == "":
+		op, err = "", errNotImplemented
+	case r.Method == http.MethodHead:
+		op, err = "GetPathProperties", s.getPath(w, r, c, key, false)
+	case r.Method == http.MethodGet:
+		op, err = "ReadPath", s.getPath(w, r, c, key, true)
+	case r.Method == http.MethodPut && r.Header.Get("X-Ms-Rename-Source") != "":
+		op, err = "RenamePath", s.renamePath(w, r, c, key)
+	case r.Method == http.MethodPut:
+		op, err = "CreatePath", s.createPath(w, r, c, key, query)
+	case r.Method == http.MethodPatch && query.Get("action") == "append":
+		op, err = "AppendData", s.appendData(w, c, key, query, body)
+	case r.Method == http.MethodPatch && query.Get("action") == "flush":
+		op, err = "FlushData", s.flushData(w, c, key, query)
+	case r.Method == http.MethodDelete:
+		op, err = "DeletePath", s.deletePath(w, c, key, query)
+	default:
+		op, err = "", errNotImplemented
+	}
+
+	return op, err
+}
+
+func (s *Server) newEntry(data []byte, dir bool) *entry {
+	e := &entry{data: data, dir: dir, modTime: time.Now().UTC().Truncate(time.Second)}
+	s.touch(e)
+	if s.hierarchical {
+		e.owner, e.group = defaultOwner, defaultOwner
+		e.permissions = defaultFilePerm
+		if dir {
+			e.permissions = defaultDirPerm
+		}
+	}
+
+	return e
+}
+
+func (s *Server) touch(e *entry) {
+	s.nextETag++
+	e.etag = fmt.Sprintf(`"0x8D7%012X"`, s.nextETag)
+	e.modTime = time.Now().UTC().Truncate(time.Second)
+}
+
+// writeContent writes the properties of an entry and, for a GET request,
+// the requested range of its content.
+func writeContent(w http.ResponseWriter, r *http.Request, e *entry, withBody bool) error {
+	size := int64(len(e.data))
+	first, last := int64(0), size-1
+	partial := false
+	if rng := r.Header.Get("Range"); rng != "" {
+		var err error
+		if first, last, err = parseRange(rng, size); err != nil {
+			return err
+		}
+		partial = true
+	}
+
+	w.Header().Set("Last-Modified", e.modTime.Format(http.TimeFormat))
+	w.Header().Set("ETag", e.etag)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	for k, v := range e.metadata {
+		w.Header().Set("X-Ms-Meta-"+k, v)
+	}
+	if !withBody {
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	w.Header().Set("Content-Length", strconv.FormatInt(last-first+1, 10))
+	if partial {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", first, last, size))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.Write(e.data[first : last+1])
+
+	return nil
+}
+
+// parseRange parses a range of bytes, such as bytes=0-99 or bytes=100-.
+func parseRange(rng string, size int64) (int64, int64, error) {
+	bounds := strings.SplitN(strings.TrimPrefix(rng, "bytes="), "-", 2)
+	if len(bounds) != 2 || !strings.HasPrefix(rng, "bytes=") {
+		return 0, 0, errInvalidRange
+	}
+
+	first, err := strconv.ParseInt(bounds[0], 10, 64)
+	if err != nil || first < 0 || first >= size {
+		return 0, 0, errInvalidRange
+	}
+	last := size - 1
+	if bounds[1] != "" {
+		if last, err = strconv.ParseInt(bounds[1], 10, 64); err != nil || last < first {
+			return 0, 0, errInvalidRange
+		}
+		if last >= size {
+			last = size - 1
+		}
+	}
+
+	return first, last, nil
+}
+
+func (s *Server) getBlob(w http.ResponseWriter, r *http.Request, c *container, key string, withBody bool) error {
+	e := c.entries[key]
+	if e == nil {
+		return errBlobNotFound
+	}
+
+	w.Header().Set("X-Ms-Blob-Type", "BlockBlob")
+	return writeContent(w, r, e, withBody)
+}
+
+// checkConditions checks the If-None-Match condition of a write.
+func checkConditions(r *http.Request, e *entry, exists error) error {
+	if e != nil && r.Header.Get("If-None-Match") == "*" {
+		return exists
+	}
+
+	return nil
+}
+
+func (s *Server) putBlob(w http.ResponseWriter, r *http.Request, c *container, key string, body []byte) error {
+	if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+		return &storageError{http.StatusBadRequest, "MissingRequiredHeader", "An HTTP header that's mandatory for this request is not specified."}
+	}
+	if err := checkConditions(r, c.entries[key], errBlobExists); err != nil {
+		return err
+	}
+
+	e := s.newEntry(body, false)
+	e.metadata = metadata(r.Header)
+	c.entries[key] = e
+	delete(c.blocks, key)
+
+	w.Header().Set("ETag", e.etag)
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (s *Server) putBlock(w http.ResponseWriter, c *container, key string, query url.Values, body []byte) error {
+	id := query.Get("blockid")
+	if _, err := base64.StdEncoding.DecodeString(id); err != nil || id == "" {
+		return &storageError{http.StatusBadRequest, "InvalidQueryParameterValue", "Value for one of the query parameters specified in the request URI is invalid."}
+	}
+
+	if c.blocks[key] == nil {
+		c.blocks[key] = make(map[string][]byte)
+	}
+	c.blocks[key][id] = body
+
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (s *Server) putBlockList(w http.ResponseWriter, r *http.Request, c *container, key string, body []byte) error {
+	var list struct {
+		Latest      []string `xml:"Latest"`
+		Uncommitted []string `xml:"Uncommitted"`
+	}
+	if err := xml.Unmarshal(body, &list); err != nil {
+		return &storageError{http.StatusBadRequest, "InvalidXmlDocument", "XML specified is not syntactically valid."}
+	}
+	if err := checkConditions(r, c.entries[key], errBlobExists); err != nil {
+		return err
+	}
+
+	var data []byte
+	for _, id := range append(list.Latest, list.Uncommitted...) {
+		block, ok := c.blocks[key][id]
+		if !ok {
+			return &storageError{http.StatusBadRequest, "InvalidBlockList", "The specified block list is invalid."}
+		}
+		data = append(data, block...)
+	}
+
+	e := s.newEntry(data, false)
+	e.metadata = metadata(r.Header)
+	c.entries[key] = e
+	delete(c.blocks, key)
+
+	w.Header().Set("ETag", e.etag)
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (s *Server) copyBlob(w http.ResponseWriter, r *http.Request, c *container, key string) error {
+	source, err := url.Parse(r.Header.Get("X-Ms-Copy-Source"))
+	if err != nil {
+		return &storageError{http.StatusBadRequest, "InvalidHeaderValue", "The value for one of the HTTP headers is not in the correct format."}
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(source.Path, "/"+s.account+"/"), "/", 2)
+	if len(parts) != 2 || s.containers[parts[0]] == nil {
+		return &storageError{http.StatusNotFound, "CannotVerifyCopySource", "The specified container does not exist."}
+	}
+	src := s.containers[parts[0]].entries[parts[1]]
+	if src == nil {
+		return &storageError{http.StatusNotFound, "CannotVerifyCopySource", "The specified blob does not exist."}
+	}
+
+	e := s.newEntry(append([]byte(nil), src.data...), false)
+	e.metadata = src.metadata
+	if m := metadata(r.Header); len(m) > 0 {
+		e.metadata = m
+	}
+	c.entries[key] = e
+
+	w.Header().Set("ETag", e.etag)
+	w.Header().Set("X-Ms-Copy-Status", "success")
+	w.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+// metadata returns the metadata set by the x-ms-meta headers.
+func metadata(header http.Header) map[string]string {
+	m := make(map[string]string)
+	for name := range header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-meta-") {
+			m[strings.TrimPrefix(lower, "x-ms-meta-")] = header.Get(name)
+		}
+	}
+
+	return m
+}
+
+func (s *Server) listBlobs(w http.ResponseWriter, c *container, query url.Values) error {
+	prefix, delimiter, marker := query.Get("prefix"), query.Get("delimiter"), query.Get("marker")
+	maxResults := s.maxResults
+	if v := query.Get("maxresults"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return &storageError{http.StatusBadRequest, "OutOfRangeQueryParameterValue", "One of the query parameters specified in the request URI is outside the permissible range."}
+		}
+		if n < maxResults {
+			maxResults = n
+		}
+	}
+
+	names := make([]string, 0, len(c.entries))
+	for name := range c.entries {
+		if strings.HasPrefix(name, prefix) && name >= marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	type blobProperties struct {
+		LastModified  string `xml:"Last-Modified"`
+		ETag          string `xml:"Etag"`
+		ContentLength int    `xml:"Content-Length"`
+		ContentType   string `xml:"Content-Type"`
+		BlobType      string `xml:"BlobType"`
+	}
+	type blobMetadata struct {
+		Items string `xml:",innerxml"`
+	}
+	type blobItem struct {
+		Name       string         `xml:"Name"`
+		Properties blobProperties `xml:"Properties"`
+		Metadata   *blobMetadata  `xml:"Metadata,omitempty"`
+	}
+	type blobPrefix struct {
+		Name string `xml:"Name"`
+	}
+	var result struct {
+		XMLName    xml.Name     `xml:"EnumerationResults"`
+		Prefix     string       `xml:"Prefix"`
+		Marker     string       `xml:"Marker"`
+		MaxResults int          `xml:"MaxResults"`
+		Delimiter  string       `xml:"Delimiter"`
+		Blobs      []blobItem   `xml:"Blobs>Blob"`
+		Prefixes   []blobPrefix `xml:"Blobs>BlobPrefix"`
+		NextMarker string       `xml:"NextMarker"`
+	}
+	result.Prefix, result.Marker, result.MaxResults, result.Delimiter = prefix, marker, maxResults, delimiter
+
+	count := 0
+	lastPrefix := ""
+	for _, name := range names {
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				p := name[:len(prefix)+i+len(delimiter)]
+				if p == lastPrefix {
+					continue
+				}
+				if count == maxResults {
+					result.NextMarker = name
+					break
+				}
+				result.Prefixes = append(result.Prefixes, blobPrefix{Name: p})
+				lastPrefix = p
+				count++
+				continue
+			}
+		}
+
+		if count == maxResults {
+			result.NextMarker = name
+			break
+		}
+		e := c.entries[name]
+		item := blobItem{
+			Name: name,
+			Properties: blobProperties{
+				LastModified:  e.modTime.Format(http.TimeFormat),
+				ETag:          e.etag,
+				ContentLength: len(e.data),
+				ContentType:   "application/octet-stream",
+				BlobType:      "BlockBlob",
+			},
+		}
+		if query.Get("include") == "metadata" {
+			var items bytes.Buffer
+			for k, v := range e.metadata {
+				items.WriteString("<" + k + ">")
+				xml.EscapeText(&items, []byte(v))
+				items.WriteString("</" + k + ">")
+			}
+			item.Metadata = &blobMetadata{Items: items.String()}
+		}
+		result.Blobs = append(result.Blobs, item)
+		count++
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	xml.NewEncoder(&buf).Encode(&result)
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+	return nil
+}
+
+// lookup returns the entry of a path of a hierarchical namespace, the root
+// directory if key is empty.
+func (c *container) lookup(key string) *entry {
+	if key == "" {
+		return c.root
+	}
+
+	return c.entries[key]
+}
+
+// mkdirs creates the missing parents of a path. None of them can be a
+// file.
+func (s *Server) mkdirs(c *container, key string) error {
+	names := strings.Split(key, "/")
+	for i := 1; i < len(names); i++ {
+		parent := strings.Join(names[:i], "/")
+		if e := c.entries[parent]; e != nil {
+			if !e.dir {
+				return errPathConflict
+			}
+			continue
+		}
+		c.entries[parent] = s.newEntry(nil, true)
+	}
+
+	return nil
+}
+
+func (s *Server) getPath(w http.ResponseWriter, r *http.Request, c *container, key string, withBody bool) error {
+	e := c.entries[key]
+	if e == nil {
+		return errPathNotFound
+	}
+
+	resourceType := "file"
+	if e.dir {
+		resourceType = "directory"
+	}
+	w.Header().Set("X-Ms-Resource-Type", resourceType)
+	w.Header().Set("X-Ms-Owner", e.owner)
+	w.Header().Set("X-Ms-Group", e.group)
+	w.Header().Set("X-Ms-Permissions", e.permissions)
+
+	return writeContent(w, r, e, withBody)
+}
+
+func (s *Server) createPath(w http.ResponseWriter, r *http.Request, c *container, key string, query url.Values) error {
+	resource := query.Get("resource")
+	if resource != "file" && resource != "directory" {
+		return invalidInput("The resource query parameter must be file or directory.")
+	}
+
+	e := c.entries[key]
+	if err := checkConditions(r, e, errPathExists); err != nil {
+		return err
+	}
+	if e != nil && e.dir != (resource == "directory") {
+		return errPathConflict
+	}
+	if err := s.mkdirs(c, key); err != nil {
+		return err
+	}
+
+	switch {
+	case e == nil:
+		c.entries[key] = s.newEntry(nil, resource == "directory")
+	case !e.dir:
+		e.data, e.pending = nil, nil
+		s.touch(e)
+	}
+
+	w.Header().Set("ETag", c.entries[key].etag)
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (s *Server) appendData(w http.ResponseWriter, c *container, key string, query url.Values, body []byte) error {
+	e := c.entries[key]
+	if e == nil {
+		return errPathNotFound
+	}
+	if e.dir {
+		return errPathConflict
+	}
+
+	position, err := strconv.Atoi(query.Get("position"))
+	if err != nil || position != len(e.data)+len(e.pending) {
+		return errInvalidPosition
+	}
+	e.pending = append(e.pending, body...)
+
+	w.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+func (s *Server) flushData(w http.ResponseWriter, c *container, key string, query url.Values) error {
+	e := c.entries[key]
+	if e == nil {
+		return errPathNotFound
+	}
+	if e.dir {
+		return errPathConflict
+	}
+
+	position, err := strconv.Atoi(query.Get("position"))
+	if err != nil || position != len(e.data)+len(e.pending) {
+		return errInvalidPosition
+	}
+	e.data = append(e.data, e.pending...)
+	e.pending = nil
+	s.touch(e)
+
+	w.Header().Set("ETag", e.etag)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) setAccessControl(w http.ResponseWriter, r *http.Request, c *container, key string) error {
+	e := c.lookup(key)
+	if e == nil {
+		return errPathNotFound
+	}
+
+	if perm := r.Header.Get("X-Ms-Permissions"); perm != "" {
+		symbolic, ok := symbolicPermissions(perm)
+		if !ok {
+			return &storageError{http.StatusBadRequest, "InvalidPermission", "The permission value is invalid."}
+		}
+		e.permissions = symbolic
+	}
+	if owner := r.Header.Get("X-Ms-Owner"); owner != "" {
+		e.owner = owner
+	}
+	if group := r.Header.Get("X-Ms-Group"); group != "" {
+		e.group = group
+	}
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// symbolicPermissions returns the symbolic form of octal or symbolic
+// permissions.
+func symbolicPermissions(perm string) (string, bool) {
+	if len(perm) == 9 {
+		return perm, true
+	}
+
+	mode, err := strconv.ParseUint(perm, 8, 32)
+	if err != nil || len(perm) != 4 || mode > 01777 {
+		return "", false
+	}
+
+	b := []byte("---------")
+	for i := 0; i < 9; i++ {
+		if mode&(1<<uint(8-i)) != 0 {
+			b[i] = "rwxrwxrwx"[i]
+		}
+	}
+	if mode&01000 != 0 {
+		if b[8] == 'x' {
+			b[8] = 't'
+		} else {
+			b[8] = 'T'
+		}
+	}
+
+	return string(b), true
+}
+
+// renamePath moves a file, or a directory with its content. The source is
+// a path of the same filesystem, optionally followed by a shared access
+// signature.
+func (s *Server) renamePath(w http.ResponseWriter, r *http.Request, c *container, key string) error {
+	source := strings.SplitN(r.Header.Get("X-Ms-Rename-Source"), "?", 2)
+	srcPath, err := url.PathUnescape(source[0])
+	if err != nil {
+		return invalidInput("The rename source is invalid.")
+	}
+	parts := strings.SplitN(strings.TrimPrefix(srcPath, "/"), "/", 2)
+	if len(parts) != 2 || s.containers[parts[0]] != c {
+		return &storageError{http.StatusNotFound, "SourcePathNotFound", "The source path for a rename operation does not exist."}
+	}
+
+	srcKey := strings.Trim(parts[1], "/")
+	src := c.entries[srcKey]
+	if src == nil {
+		return &storageError{http.StatusNotFound, "SourcePathNotFound", "The source path for a rename operation does not exist."}
+	}
+	if key == srcKey || strings.HasPrefix(key, srcKey+"/") {
+		return &storageError{http.StatusBadRequest, "InvalidDestinationPath", "The specified path, or an element of the path, is invalid."}
+	}
+
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		if parent := c.entries[key[:i]]; parent == nil || !parent.dir {
+			return &storageError{http.StatusNotFound, "RenameDestinationParentPathNotFound", "The parent directory of the destination path does not exist."}
+		}
+	}
+	if dst := c.entries[key]; dst != nil {
+		if dst.dir || src.dir {
+			return &storageError{http.StatusConflict, "InvalidSourceOrDestinationResourceType", "The source and destination resource type must be identical."}
+		}
+	}
+
+	moved := make(map[string]*entry)
+	for name, e := range c.entries {
+		if name == srcKey || strings.HasPrefix(name, srcKey+"/") {
+			moved[key+strings.TrimPrefix(name, srcKey)] = e
+			delete(c.entries, name)
+		}
+	}
+	for name, e := range moved {
+		c.entries[name] = e
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (s *Server) deletePath(w http.ResponseWriter, c *container, key string, query url.Values) error {
+	e := c.entries[key]
+	if e == nil {
+		return errPathNotFound
+	}
+
+	recursive := query.Get("recursive") == "true"
+	for name := range c.entries {
+		if strings.HasPrefix(name, key+"/") {
+			if !recursive {
+				return errDirNotEmpty
+			}
+			delete(c.entries, name)
+		}
+	}
+	delete(c.entries, key)
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) listPaths(w http.ResponseWriter, c *container, query url.Values) error {
+	if query.Get("recursive") == "" {
+		return &storageError{http.StatusBadRequest, "MissingRequiredQueryParameter", "A query parameter that's mandatory for this request is not specified."}
+	}
+	recursive := query.Get("recursive") == "true"
+
+	dir := strings.Trim(query.Get("directory"), "/")
+	if d := c.lookup(dir); d == nil {
+		return errPathNotFound
+	} else if !d.dir {
+		return errPathConflict
+	}
+
+	maxResults := s.maxResults
+	if v := query.Get("maxResults"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return invalidInput("The maxResults query parameter is invalid.")
+		}
+		if n < maxResults {
+			maxResults = n
+		}
+	}
+
+	prefix := ""
+	if dir != "" {
+		prefix = dir + "/"
+	}
+	var names []string
+	for name := range c.entries {
+		rest := strings.TrimPrefix(name, prefix)
+		if rest == name && prefix != "" {
+			continue
+		}
+		if (recursive || !strings.Contains(rest, "/")) && name >= query.Get("continuation") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) > maxResults {
+		w.Header().Set(headerContinuation, names[maxResults])
+		names = names[:maxResults]
+	}
+
+	type pathItem struct {
+		Name          string `json:"name"`
+		IsDirectory   string `json:"isDirectory,omitempty"`
+		ContentLength string `json:"contentLength"`
+		LastModified  string `json:"lastModified"`
+		ETag          string `json:"etag"`
+		Owner         string `json:"owner"`
+		Group         string `json:"group"`
+		Permissions   string `json:"permissions"`
+	}
+	result := struct {
+		Paths []pathItem `json:"paths"`
+	}{Paths: []pathItem{}}
+	for _, name := range names {
+		e := c.entries[name]
+		item := pathItem{
+			Name:          name,
+			ContentLength: strconv.Itoa(len(e.data)),
+			LastModified:  e.modTime.Format(http.TimeFormat),
+			ETag:          e.etag,
+			Owner:         e.owner,
+			Group:         e.group,
+			Permissions:   e.permissions,
+		}
+		if e.dir {
+			item.IsDirectory = "true"
+		}
+		result.Paths = append(result.Paths, item)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	return json.NewEncoder(w).Encode(&result)
+}
+
+// authenticate checks the Shared Key signature of a request, or its shared
+// access signature.
+func (s *Server) authenticate(r *http.Request) error {
+	if s.key == nil {
+		return nil
+	}
+
+	failed := func(message string) error {
+		return &storageError{http.StatusForbidden, "AuthenticationFailed", message}
+	}
+
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		if r.URL.Query().Get("sig") == sasSignature {
+			return nil
+		}
+		return &storageError{http.StatusUnauthorized, "NoAuthenticationInformation", "Server failed to authenticate the request. Please refer to the information in the www-authenticate header."}
+	}
+
+	prefix := "SharedKey " + s.account + ":"
+	if !strings.HasPrefix(auth, prefix) {
+		return failed("The MAC signature found in the HTTP request is not the same as any computed signature.")
+	}
+
+	var b strings.Builder
+	b.WriteString(r.Method + "\n")
+	for _, name := range []string{"Content-Encoding", "Content-Language", "Content-Length", "Content-MD5", "Content-Type", "Date",
+		"If-Modified-Since", "If-Match", "If-None-Match", "If-Unmodified-Since", "Range"} {
+		value := r.Header.Get(name)
+		if name == "Content-Length" && value == "0" {
+			value = ""
+		}
+		b.WriteString(value + "\n")
+	}
+
+	var headers []string
+	for name, values := range r.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			headers = append(headers, lower+":"+strings.TrimSpace(strings.Join(values, ",")))
+		}
+	}
+	sort.Strings(headers)
+	for _, h := range headers {
+		b.WriteString(h + "\n")
+	}
+
+	uri := strings.SplitN(r.RequestURI, "?", 2)
+	b.WriteString("/" + s.account + uri[0])
+	if len(uri) == 2 {
+		query, err := url.ParseQuery(uri[1])
+		if err != nil {
+			return failed("The query of the request is invalid.")
+		}
+		var params []string
+		for name, values := range query {
+			values = append([]string(nil), values...)
+			sort.Strings(values)
+			params = append(params, strings.ToLower(name)+":"+strings.Join(values, ","))
+		}
+		sort.Strings(params)
+		for _, p := range params {
+			b.WriteString("\n" + p)
+		}
+	}
+
+	h := hmac.New(sha256.New, s.key)
+	h.Write([]byte(b.String()))
+	signature := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	if !hmac.Equal([]byte(signature), []byte(strings.TrimPrefix(auth, prefix))) {
+		return failed("The MAC signature found in the HTTP request is not the same as any computed signature.")
+	}
+
+	return nil
+}
+
+// writeError writes the code of an error in a header, and its message in
+// an XML body for the Blob service and in a JSON body for the Data Lake
+// service. The responses to HEAD requests have no body.
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	e, ok := err.(*storageError)
+	if !ok {
+		e = &storageError{http.StatusInternalServerError, "InternalError", err.Error()}
+	}
+
+	w.Header().Set(headerErrorCode, e.code)
+	if r.Method == http.MethodHead {
+		w.WriteHeader(e.status)
+		return
+	}
+
+	var buf bytes.Buffer
+	if s.hierarchical {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(&buf).Encode(map[string]interface{}{
+			"error": map[string]string{"code": e.code, "message": e.message},
+		})
+	} else {
+		w.Header().Set("Content-Type", "application/xml")
+		buf.WriteString(xml.Header)
+		xml.NewEncoder(&buf).Encode(&struct {
+			XMLName xml.Name `xml:"Error"`
+			Code    string   `xml:"Code"`
+			Message string   `xml:"Message"`
+		}{Code: e.code, Message: e.message})
+	}
+
+	w.WriteHeader(e.status)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,614 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// copyPollInterval is the interval at which the status of a pending copy
+// is checked.
+var copyPollInterval = 100 * time.Millisecond
+
+// blob is a filesystem based on a container of the Blob service.
+type blob struct {
+	client *client
+	base   string
+}
+
+// NewBlob returns a filesystem on a container of the Blob service, such as
+// https://account.blob.core.windows.net. The base directory is the prefix
+// of the blob names, without its leading slash.
+func NewBlob(endpoint, container, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+
+	c, err := newClient(endpoint, container, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blob{client: c, base: baseDir}, nil
+}
+
+func (fs *blob) Create(filename string) (extfs.File, error) {
+	return fs.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+func (fs *blob) Open(filename string) (extfs.File, error) {
+	return fs.OpenFile(filename, os.O_RDONLY, 0)
+}
+
+// OpenFile opens a blob for reading, or for writing a new content. The
+// block blobs cannot be appended to, nor written in place, so opening an
+// existing blob for writing requires O_TRUNC.
+func (fs *blob) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	accMode := flag & syscall.O_ACCMODE
+	if accMode == os.O_RDWR {
+		return nil, errors.New("Azure file can only be opened as read-only or write-only")
+	}
+
+	fi, err := fs.stat(fullpath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if accMode == os.O_RDONLY {
+		if !exists {
+			return nil, err
+		}
+		if fi.IsDir() {
+			return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+		}
+		return newReader(fs, fs.client, filename, fullpath, fi.Size()), nil
+	}
+
+	switch {
+	case exists && fi.IsDir():
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+	case exists && flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrExist}
+	case !exists && flag&os.O_CREATE == 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrNotExist}
+	case exists && flag&os.O_APPEND != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: extfs.ErrUnsupported}
+	case exists && flag&os.O_TRUNC == 0:
+		return nil, errors.New("Azure blob can only be replaced, open it with O_TRUNC")
+	}
+
+	upload := &blockUpload{client: fs.client, key: pathKey(fullpath), exclusive: flag&os.O_EXCL != 0}
+	return newWriter(fs, fs.client, filename, fullpath, upload), nil
+}
+
+func (fs *blob) Remove(filename string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return err
+	}
+
+	k := pathKey(fullpath)
+	if fi.IsDir() {
+		result, err := fs.listBlobs(dirKey(k), "", "", 1)
+		if err != nil {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+		}
+		if len(result.Blobs) > 0 {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: syscall.ENOTEMPTY}
+		}
+		if k == "" {
+			return nil
+		}
+	}
+
+	if err := fs.deleteBlob(k); err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return fs.keepParent(fullpath)
+}
+
+func (fs *blob) RemoveAll(path string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	k := pathKey(fullpath)
+	var keys []string
+	if k != "" {
+		keys = append(keys, k)
+	}
+	err = fs.walk(dirKey(k), func(item *blobItem) error {
+		keys = append(keys, item.Name)
+		return nil
+	})
+	if err == nil {
+		err = fs.deleteBlobs(keys)
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return fs.keepParent(fullpath)
+}
+
+// Rename copies the blobs to their new names, then deletes them.
+func (fs *blob) Rename(oldpath, newpath string) error {
+	var err error
+	oldpath, err = util.UnderlyingPath(fs.base, oldpath)
+	if err != nil {
+		return err
+	}
+
+	newpath, err = util.UnderlyingPath(fs.base, newpath)
+	if err != nil {
+		return err
+	}
+
+	src, err := fs.stat(oldpath)
+	if err != nil {
+		return err
+	}
+	oldKey, newKey := pathKey(oldpath), pathKey(newpath)
+	if oldKey == newKey {
+		return nil
+	}
+	if oldKey == "" || newKey == "" || strings.HasPrefix(newKey, dirKey(oldKey)) {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: os.ErrInvalid}
+	}
+
+	dst, err := fs.stat(newpath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if !src.IsDir() {
+		if dst != nil && dst.IsDir() {
+			return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: syscall.EISDIR}
+		}
+		if err := fs.copyBlob(oldKey, newKey); err != nil {
+			return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+		}
+		if err := fs.deleteBlob(oldKey); err != nil {
+			return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+		}
+		return fs.keepParent(oldpath)
+	}
+
+	if dst != nil {
+		if !dst.IsDir() {
+			return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: syscall.ENOTDIR}
+		}
+		result, err := fs.listBlobs(dirKey(newKey), "", "", 1)
+		if err != nil {
+			return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+		}
+		if len(result.Blobs) > 0 {
+			return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: syscall.ENOTEMPTY}
+		}
+	}
+
+	var keys []string
+	err = fs.walk(dirKey(oldKey), func(item *blobItem) error {
+		target := dirKey(newKey) + strings.TrimPrefix(item.Name, dirKey(oldKey))
+		if err := fs.copyBlob(item.Name, target); err != nil {
+			return err
+		}
+		keys = append(keys, item.Name)
+		return nil
+	})
+	if err == nil && src.(*fileInfo).props != nil {
+		// The marker of the directory is moved last.
+		if err = fs.putMarker(newKey); err == nil {
+			keys = append(keys, oldKey)
+		}
+	}
+	if err == nil {
+		err = fs.deleteBlobs(keys)
+	}
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+	}
+
+	return fs.keepParent(oldpath)
+}
+
+func (fs *blob) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.stat(fullpath)
+}
+
+func (fs *blob) ReadDir(path string) ([]os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := dirKey(pathKey(fullpath))
+	found := prefix == ""
+
+	// A directory with a marker is listed both as a blob and as a prefix.
+	entries := make(map[string]*fileInfo)
+	marker := ""
+	for {
+		result, err := fs.listBlobs(prefix, "/", marker, 0)
+		if err != nil {
+			return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretError(err)}
+		}
+
+		for _, p := range result.Prefixes {
+			found = true
+			name := strings.TrimSuffix(strings.TrimPrefix(p.Name, prefix), "/")
+			if _, ok := entries[name]; !ok {
+				entries[name] = &fileInfo{name: name, dir: true}
+			}
+		}
+		for _, item := range result.Blobs {
+			found = true
+			props := item.properties()
+			entries[strings.TrimPrefix(item.Name, prefix)] = &fileInfo{name: strings.TrimPrefix(item.Name, prefix), dir: props.Directory, props: props}
+		}
+
+		if result.NextMarker == "" {
+			break
+		}
+		marker = result.NextMarker
+	}
+
+	if !found {
+		fi, err := fs.stat(fullpath)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: syscall.ENOTDIR}
+		}
+	}
+
+	fis := make([]os.FileInfo, 0, len(entries))
+	for _, fi := range entries {
+		fis = append(fis, fi)
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+// MkdirAll stores a marker blob for the directory and each of its missing
+// parents. The permissions are ignored.
+func (fs *blob) MkdirAll(path string, perm os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	k := pathKey(fullpath)
+	if k == "" {
+		return nil
+	}
+
+	names := strings.Split(k, "/")
+	for i := range names {
+		key := strings.Join(names[:i+1], "/")
+		header, err := fs.client.properties(key)
+		if err == nil {
+			if !headerProperties(key, header).Directory {
+				return &os.PathError{Op: "mkdir", Path: fullpath, Err: syscall.ENOTDIR}
+			}
+			continue
+		}
+		if !os.IsNotExist(interpretError(err)) {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+		}
+
+		if err := fs.putMarker(key); err != nil {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+		}
+	}
+
+	return nil
+}
+
+func (fs *blob) Chmod(name string, mode os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	return &os.PathError{Op: "chmod", Path: fullpath, Err: extfs.ErrUnsupported}
+}
+
+func (fs *blob) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	return &os.PathError{Op: "chtimes", Path: fullpath, Err: extfs.ErrUnsupported}
+}
+
+func (fs *blob) Close() error {
+	fs.client.http.CloseIdleConnections()
+	return nil
+}
+
+// stat returns the properties of a blob. A directory without a marker is
+// found by listing the blobs starting with its prefix.
+func (fs *blob) stat(fullpath string) (os.FileInfo, error) {
+	k := pathKey(fullpath)
+	if k == "" {
+		return &fileInfo{name: "/", dir: true}, nil
+	}
+
+	header, err := fs.client.properties(k)
+	if err == nil {
+		props := headerProperties(k, header)
+		return &fileInfo{name: path.Base(k), dir: props.Directory, props: props}, nil
+	}
+	if err = interpretError(err); !os.IsNotExist(err) {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: err}
+	}
+
+	result, err := fs.listBlobs(dirKey(k), "", "", 1)
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretError(err)}
+	}
+	if len(result.Blobs) == 0 {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: os.ErrNotExist}
+	}
+
+	return &fileInfo{name: path.Base(k), dir: true}, nil
+}
+
+// keepParent stores a marker for the parent directory of a removed path if
+// it has no blob left, so that the directory still exists.
+func (fs *blob) keepParent(fullpath string) error {
+	parent := pathKey(filepath.Dir(fullpath))
+	if parent == "" {
+		return nil
+	}
+
+	result, err := fs.listBlobs(dirKey(parent), "", "", 1)
+	if err == nil && len(result.Blobs) == 0 {
+		err = fs.putMarker(parent)
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+// putMarker stores the empty blob which marks a directory.
+func (fs *blob) putMarker(key string) error {
+	header := http.Header{
+		"X-Ms-Blob-Type":         {"BlockBlob"},
+		"X-Ms-Meta-Hdi_isfolder": {"true"},
+	}
+	_, err := fs.client.call(http.MethodPut, key, nil, header, nil)
+	return err
+}
+
+func (fs *blob) deleteBlob(key string) error {
+	_, err := fs.client.call(http.MethodDelete, key, nil, nil, nil)
+	return err
+}
+
+// deleteBlobs deletes blobs one by one, ignoring the missing ones.
+func (fs *blob) deleteBlobs(keys []string) error {
+	for _, k := range keys {
+		if err := fs.deleteBlob(k); err != nil && !os.IsNotExist(interpretError(err)) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyBlob copies a blob within the container, and waits for the copy to
+// complete when the service runs it asynchronously.
+func (fs *blob) copyBlob(src, dst string) error {
+	header := http.Header{"X-Ms-Copy-Source": {fs.client.url(src, nil).String()}}
+	result, err := fs.client.call(http.MethodPut, dst, nil, header, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		switch status := result.Get("X-Ms-Copy-Status"); status {
+		case "", "success":
+			return nil
+		case "pending":
+		default:
+			return fmt.Errorf("Azure copy of %s to %s %s: %s", src, dst, status, result.Get("X-Ms-Copy-Status-Description"))
+		}
+
+		time.Sleep(copyPollInterval)
+		if result, err = fs.client.properties(dst); err != nil {
+			return err
+		}
+	}
+}
+
+// blobItem is a blob of a listing.
+type blobItem struct {
+	Name       string `xml:"Name"`
+	Properties struct {
+		LastModified  string `xml:"Last-Modified"`
+		ETag          string `xml:"Etag"`
+		ContentLength int64  `xml:"Content-Length"`
+		ContentType   string `xml:"Content-Type"`
+	} `xml:"Properties"`
+	Metadata struct {
+		IsFolder string `xml:"hdi_isfolder"`
+	} `xml:"Metadata"`
+}
+
+func (item *blobItem) properties() *Properties {
+	props := &Properties{
+		Name:          item.Name,
+		Directory:     item.Metadata.IsFolder == "true",
+		ContentLength: item.Properties.ContentLength,
+		ETag:          item.Properties.ETag,
+		ContentType:   item.Properties.ContentType,
+	}
+	if t, err := http.ParseTime(item.Properties.LastModified); err == nil {
+		props.LastModified = t
+	}
+
+	return props
+}
+
+// blobList is a page of the listing of the blobs of a container.
+type blobList struct {
+	Blobs    []*blobItem `xml:"Blobs>Blob"`
+	Prefixes []struct {
+		Name string `xml:"Name"`
+	} `xml:"Blobs>BlobPrefix"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+// listBlobs lists a page of the blobs whose name starts with a prefix. The
+// names containing the delimiter after the prefix are grouped into prefixes
+// if it is not empty.
+func (fs *blob) listBlobs(prefix, delimiter, marker string, maxResults int) (*blobList, error) {
+	params := url.Values{"restype": {"container"}, "comp": {"list"}, "include": {"metadata"}}
+	if prefix != "" {
+		params.Set("prefix", prefix)
+	}
+	if delimiter != "" {
+		params.Set("delimiter", delimiter)
+	}
+	if marker != "" {
+		params.Set("marker", marker)
+	}
+	if maxResults > 0 {
+		params.Set("maxresults", strconv.Itoa(maxResults))
+	}
+
+	resp, err := fs.client.do(http.MethodGet, "", params, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	result := &blobList{}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// walk calls fn for each blob whose name starts with a prefix.
+func (fs *blob) walk(prefix string, fn func(item *blobItem) error) error {
+	marker := ""
+	for {
+		result, err := fs.listBlobs(prefix, "", marker, 0)
+		if err != nil {
+			return err
+		}
+		for _, item := range result.Blobs {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		if result.NextMarker == "" {
+			return nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+// blockUpload uploads the blocks of a block blob, and commits their list
+// once all are sent.
+type blockUpload struct {
+	client    *client
+	key       string
+	exclusive bool
+	blocks    []string
+}
+
+func (u *blockUpload) upload(data []byte) error {
+	id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", len(u.blocks))))
+	params := url.Values{"comp": {"block"}, "blockid": {id}}
+	if _, err := u.client.call(http.MethodPut, u.key, params, nil, data); err != nil {
+		return err
+	}
+	u.blocks = append(u.blocks, id)
+
+	return nil
+}
+
+// commit stores a small blob in one request, a larger one by committing
+// the list of its blocks.
+func (u *blockUpload) commit(data []byte) error {
+	header := http.Header{}
+	if u.exclusive {
+		header.Set("If-None-Match", "*")
+	}
+
+	if len(u.blocks) == 0 {
+		header.Set("X-Ms-Blob-Type", "BlockBlob")
+		_, err := u.client.call(http.MethodPut, u.key, nil, header, data)
+		return err
+	}
+
+	if len(data) > 0 {
+		if err := u.upload(data); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header + "<BlockList>")
+	for _, id := range u.blocks {
+		buf.WriteString("<Latest>" + id + "</Latest>")
+	}
+	buf.WriteString("</BlockList>")
+
+	_, err := u.client.call(http.MethodPut, u.key, url.Values{"comp": {"blocklist"}}, header, buf.Bytes())
+	return err
+}
+
+// abort leaves the uncommitted blocks, which the service discards.
+func (u *blockUpload) abort() {
+}
@@ -0,0 +1,130 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// apiVersion is the version of the REST API of the requests.
+const apiVersion = "2019-12-12"
+
+// client sends the requests of the Blob or the Data Lake service on a
+// container, which the Data Lake service calls a filesystem.
+type client struct {
+	http      *http.Client
+	endpoint  *url.URL
+	container string
+
+	// key is nil when the requests are not signed, sas is nil when no
+	// shared access signature is added to them.
+	key *sharedKey
+	sas url.Values
+}
+
+// url returns the URL of a path of the container, or of the container if
+// name is empty. The name / is the root directory of the container.
+func (c *client) url(name string, params url.Values) *url.URL {
+	p := strings.TrimSuffix(c.endpoint.Path, "/") + "/" + c.container
+	if name != "" {
+		p += "/" + strings.TrimPrefix(name, "/")
+	}
+
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	if c.key == nil {
+		for k, v := range c.sas {
+			query[k] = v
+		}
+	}
+
+	return &url.URL{
+		Scheme:   c.endpoint.Scheme,
+		Host:     c.endpoint.Host,
+		Path:     p,
+		RawPath:  uriEncode(p),
+		RawQuery: query.Encode(),
+	}
+}
+
+// do sends a request with an optional body. The response is an *Error if
+// its status is not a success.
+func (c *client) do(method, name string, params url.Values, header http.Header, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.url(name, params).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		req.Body = nil
+		req.GetBody = nil
+	}
+	req.ContentLength = int64(len(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("X-Ms-Version", apiVersion)
+	req.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+	if c.key != nil {
+		c.key.sign(req)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		return nil, readError(resp)
+	}
+
+	return resp, nil
+}
+
+// call sends a request and discards the body of its response, whose
+// header is returned.
+func (c *client) call(method, name string, params url.Values, header http.Header, body []byte) (http.Header, error) {
+	resp, err := c.do(method, name, params, header, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	return resp.Header, nil
+}
+
+// properties returns the header of the response to a HEAD request.
+func (c *client) properties(name string) (http.Header, error) {
+	return c.call(http.MethodHead, name, nil, nil, nil)
+}
+
+// get reads length bytes of a file from offset, or up to its end if length
+// is negative.
+func (c *client) get(name string, offset, length int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	if length >= 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	} else if offset > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := c.do(http.MethodGet, name, nil, header, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,537 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// datalake is a filesystem based on a filesystem of the Data Lake Storage
+// Gen2 service.
+type datalake struct {
+	client *client
+	base   string
+}
+
+// NewDataLake returns a filesystem on a filesystem of the Data Lake
+// service, such as https://account.dfs.core.windows.net. The base
+// directory is a path of the filesystem.
+func NewDataLake(endpoint, filesystem, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+
+	c, err := newClient(endpoint, filesystem, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &datalake{client: c, base: baseDir}, nil
+}
+
+func (fs *datalake) Create(filename string) (extfs.File, error) {
+	return fs.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+func (fs *datalake) Open(filename string) (extfs.File, error) {
+	return fs.OpenFile(filename, os.O_RDONLY, 0)
+}
+
+// OpenFile opens a file for reading, or for writing a new content or
+// appending to it. The files cannot be written in place, so opening an
+// existing file for writing requires O_TRUNC or O_APPEND.
+func (fs *datalake) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	accMode := flag & syscall.O_ACCMODE
+	if accMode == os.O_RDWR {
+		return nil, errors.New("Azure file can only be opened as read-only or write-only")
+	}
+
+	fi, err := fs.stat(fullpath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if accMode == os.O_RDONLY {
+		if !exists {
+			return nil, err
+		}
+		if fi.IsDir() {
+			return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+		}
+		return newReader(fs, fs.client, filename, fullpath, fi.Size()), nil
+	}
+
+	k := pathKey(fullpath)
+	switch {
+	case exists && fi.IsDir():
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+	case exists && flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrExist}
+	case !exists && flag&os.O_CREATE == 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrNotExist}
+	case exists && flag&os.O_APPEND != 0:
+		upload := &appendUpload{client: fs.client, key: k, position: fi.Size()}
+		return newWriter(fs, fs.client, filename, fullpath, upload), nil
+	case exists && flag&os.O_TRUNC == 0:
+		return nil, errors.New("Azure file can only be replaced or appended to, open it with O_TRUNC or O_APPEND")
+	}
+
+	// The file is created, or truncated, before it is written. The parent
+	// directories are created with it.
+	header := http.Header{}
+	if flag&os.O_EXCL != 0 {
+		header.Set("If-None-Match", "*")
+	}
+	if _, err := fs.client.call(http.MethodPut, k, url.Values{"resource": {"file"}}, header, nil); err != nil {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: interpretError(err)}
+	}
+
+	upload := &appendUpload{client: fs.client, key: k}
+	return newWriter(fs, fs.client, filename, fullpath, upload), nil
+}
+
+func (fs *datalake) Remove(filename string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return err
+	}
+
+	k := pathKey(fullpath)
+	if k == "" {
+		paths, _, err := fs.listPaths("", "", 1)
+		if err != nil {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+		}
+		if len(paths) > 0 {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: syscall.ENOTEMPTY}
+		}
+		return nil
+	}
+
+	_, err = fs.client.call(http.MethodDelete, k, url.Values{"recursive": {"false"}}, nil, nil)
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *datalake) RemoveAll(path string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	// The root cannot be deleted, its content is.
+	keys := []string{pathKey(fullpath)}
+	if keys[0] == "" {
+		keys = nil
+		token := ""
+		for {
+			paths, next, err := fs.listPaths("", token, 0)
+			if err != nil {
+				return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+			}
+			for _, p := range paths {
+				keys = append(keys, p.Name)
+			}
+			if next == "" {
+				break
+			}
+			token = next
+		}
+	}
+
+	for _, k := range keys {
+		if err := fs.deleteAll(k); err != nil {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+		}
+	}
+
+	return nil
+}
+
+// Rename renames a file or a directory in one request, atomically.
+func (fs *datalake) Rename(oldpath, newpath string) error {
+	var err error
+	oldpath, err = util.UnderlyingPath(fs.base, oldpath)
+	if err != nil {
+		return err
+	}
+
+	newpath, err = util.UnderlyingPath(fs.base, newpath)
+	if err != nil {
+		return err
+	}
+
+	oldKey, newKey := pathKey(oldpath), pathKey(newpath)
+	if oldKey == newKey {
+		return nil
+	}
+	if oldKey == "" || newKey == "" || strings.HasPrefix(newKey, dirKey(oldKey)) {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: os.ErrInvalid}
+	}
+
+	src, err := fs.stat(oldpath)
+	if err != nil {
+		return err
+	}
+	dst, err := fs.stat(newpath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// The service replaces an existing file only, an empty directory is
+	// removed first like os.Rename does.
+	switch {
+	case dst == nil:
+		if parent := pathKey(filepath.Dir(newpath)); parent != "" {
+			if err := fs.mkdir(parent); err != nil {
+				return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+			}
+		}
+	case !src.IsDir() && dst.IsDir():
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: syscall.EISDIR}
+	case src.IsDir() && !dst.IsDir():
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: syscall.ENOTDIR}
+	case src.IsDir():
+		_, err := fs.client.call(http.MethodDelete, newKey, url.Values{"recursive": {"false"}}, nil, nil)
+		if err != nil {
+			return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+		}
+	}
+
+	source := "/" + fs.client.container + "/" + uriEncode(oldKey)
+	if fs.client.key == nil && fs.client.sas != nil {
+		source += "?" + fs.client.sas.Encode()
+	}
+	header := http.Header{"X-Ms-Rename-Source": {source}}
+	if _, err := fs.client.call(http.MethodPut, newKey, url.Values{"mode": {"legacy"}}, header, nil); err != nil {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *datalake) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.stat(fullpath)
+}
+
+func (fs *datalake) ReadDir(path string) ([]os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	k := pathKey(fullpath)
+	var fis []os.FileInfo
+	token := ""
+	for {
+		paths, next, err := fs.listPaths(k, token, 0)
+		if err != nil {
+			if fi, err := fs.stat(fullpath); err == nil && !fi.IsDir() {
+				return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: syscall.ENOTDIR}
+			}
+			return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretError(err)}
+		}
+
+		for _, p := range paths {
+			props := p.properties()
+			fis = append(fis, &fileInfo{name: strings.TrimPrefix(p.Name, dirKey(k)), dir: props.Directory, props: props})
+		}
+
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+// MkdirAll creates a directory with its parents. The permissions are the
+// default ones of the service.
+func (fs *datalake) MkdirAll(path string, perm os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	k := pathKey(fullpath)
+	if k == "" {
+		return nil
+	}
+
+	if fi, err := fs.stat(fullpath); err == nil {
+		if !fi.IsDir() {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: syscall.ENOTDIR}
+		}
+		return nil
+	}
+
+	if err := fs.mkdir(k); err != nil {
+		// One of the parents is a file.
+		var azErr *Error
+		if errors.As(err, &azErr) && azErr.StatusCode == http.StatusConflict {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: syscall.ENOTDIR}
+		}
+		return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *datalake) Chmod(name string, mode os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{"X-Ms-Permissions": {formatPermissions(mode)}}
+	if err := fs.setAccessControl(pathKey(fullpath), header); err != nil {
+		return &os.PathError{Op: "chmod", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *datalake) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	return &os.PathError{Op: "chtimes", Path: fullpath, Err: extfs.ErrUnsupported}
+}
+
+func (fs *datalake) Owner(name string) (string, string, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return "", "", err
+	}
+	props := fi.(*fileInfo).props
+	if props == nil {
+		return "", "", &os.PathError{Op: "owner", Path: fullpath, Err: extfs.ErrUnsupported}
+	}
+
+	return props.Owner, props.Group, nil
+}
+
+func (fs *datalake) Chown(name, user, group string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	if user != "" {
+		header.Set("X-Ms-Owner", user)
+	}
+	if group != "" {
+		header.Set("X-Ms-Group", group)
+	}
+	if err := fs.setAccessControl(pathKey(fullpath), header); err != nil {
+		return &os.PathError{Op: "chown", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *datalake) Close() error {
+	fs.client.http.CloseIdleConnections()
+	return nil
+}
+
+// stat returns the properties of a path. The root has none.
+func (fs *datalake) stat(fullpath string) (os.FileInfo, error) {
+	k := pathKey(fullpath)
+	if k == "" {
+		return &fileInfo{name: "/", dir: true}, nil
+	}
+
+	header, err := fs.client.properties(k)
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretError(err)}
+	}
+	props := headerProperties(k, header)
+
+	return &fileInfo{name: path.Base(k), dir: props.Directory, props: props}, nil
+}
+
+// mkdir creates a directory and its parents.
+func (fs *datalake) mkdir(key string) error {
+	_, err := fs.client.call(http.MethodPut, key, url.Values{"resource": {"directory"}}, nil, nil)
+	return err
+}
+
+// deleteAll deletes a path and its content. The deletion of a large
+// directory is continued over several requests. A missing path is ignored.
+func (fs *datalake) deleteAll(key string) error {
+	token := ""
+	for {
+		params := url.Values{"recursive": {"true"}}
+		if token != "" {
+			params.Set("continuation", token)
+		}
+		header, err := fs.client.call(http.MethodDelete, key, params, nil, nil)
+		if err != nil {
+			if os.IsNotExist(interpretError(err)) {
+				return nil
+			}
+			return err
+		}
+
+		token = header.Get("X-Ms-Continuation")
+		if token == "" {
+			return nil
+		}
+	}
+}
+
+func (fs *datalake) setAccessControl(key string, header http.Header) error {
+	if key == "" {
+		key = "/"
+	}
+	_, err := fs.client.call(http.MethodPatch, key, url.Values{"action": {"setAccessControl"}}, header, nil)
+	return err
+}
+
+// pathItem is a path of the listing of a directory. The numbers and the
+// booleans are sent as strings.
+type pathItem struct {
+	Name          string          `json:"name"`
+	IsDirectory   json.RawMessage `json:"isDirectory"`
+	ContentLength json.RawMessage `json:"contentLength"`
+	LastModified  string          `json:"lastModified"`
+	ETag          string          `json:"etag"`
+	Owner         string          `json:"owner"`
+	Group         string          `json:"group"`
+	Permissions   string          `json:"permissions"`
+}
+
+func (p *pathItem) properties() *Properties {
+	props := &Properties{
+		Name:        p.Name,
+		Directory:   unquote(p.IsDirectory) == "true",
+		ETag:        p.ETag,
+		Owner:       p.Owner,
+		Group:       p.Group,
+		Permissions: p.Permissions,
+	}
+	props.ContentLength, _ = strconv.ParseInt(unquote(p.ContentLength), 10, 64)
+	if t, err := http.ParseTime(p.LastModified); err == nil {
+		props.LastModified = t
+	}
+
+	return props
+}
+
+// listPaths lists a page of the content of a directory, which is the root
+// if key is empty. It returns the continuation token of the next page.
+func (fs *datalake) listPaths(key, token string, maxResults int) ([]*pathItem, string, error) {
+	params := url.Values{"resource": {"filesystem"}, "recursive": {"false"}}
+	if key != "" {
+		params.Set("directory", key)
+	}
+	if token != "" {
+		params.Set("continuation", token)
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+
+	resp, err := fs.client.do(http.MethodGet, "", params, nil, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Paths []*pathItem `json:"paths"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, "", err
+	}
+
+	return result.Paths, resp.Header.Get("X-Ms-Continuation"), nil
+}
+
+// appendUpload appends the blocks to a Data Lake file, and flushes them
+// once all are sent.
+type appendUpload struct {
+	client   *client
+	key      string
+	position int64
+}
+
+func (u *appendUpload) upload(data []byte) error {
+	params := url.Values{"action": {"append"}, "position": {strconv.FormatInt(u.position, 10)}}
+	if _, err := u.client.call(http.MethodPatch, u.key, params, nil, data); err != nil {
+		return err
+	}
+	u.position += int64(len(data))
+
+	return nil
+}
+
+func (u *appendUpload) commit(data []byte) error {
+	if len(data) > 0 {
+		if err := u.upload(data); err != nil {
+			return err
+		}
+	}
+
+	params := url.Values{"action": {"flush"}, "position": {strconv.FormatInt(u.position, 10)}, "close": {"true"}}
+	_, err := u.client.call(http.MethodPatch, u.key, params, nil, nil)
+	return err
+}
+
+// abort leaves the data appended and not flushed, which the service
+// discards.
+func (u *appendUpload) abort() {
+}
+
+// unquote returns a JSON string or the text of another JSON value.
+func unquote(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+
+	return string(raw)
+}
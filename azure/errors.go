@@ -0,0 +1,106 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+// Error is the error returned by the storage service when a request fails.
+type Error struct {
+	Code    string
+	Message string
+
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return e.Code
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// readError decodes the error of a failed response. The code is sent in a
+// header, the message in an XML body by the Blob service and in a JSON body
+// by the Data Lake service. The responses to HEAD requests have no body.
+func readError(resp *http.Response) error {
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	e := &Error{Code: resp.Header.Get("X-Ms-Error-Code"), StatusCode: resp.StatusCode}
+	var blobError struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	var pathError struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	switch {
+	case xml.Unmarshal(data, &blobError) == nil && blobError.Code != "":
+		e.Code, e.Message = blobError.Code, blobError.Message
+	case json.Unmarshal(data, &pathError) == nil && pathError.Error.Code != "":
+		e.Code, e.Message = pathError.Error.Code, pathError.Error.Message
+	}
+	if e.Code == "" {
+		e.Code = http.StatusText(resp.StatusCode)
+	}
+
+	return e
+}
+
+func interpretError(err error) error {
+	var azErr *Error
+	if !errors.As(err, &azErr) {
+		return err
+	}
+
+	switch azErr.Code {
+	case "BlobNotFound", "PathNotFound", "ContainerNotFound", "FilesystemNotFound",
+		"ResourceNotFound", "RenameDestinationParentPathNotFound", "SourcePathNotFound":
+		return os.ErrNotExist
+	case "BlobAlreadyExists", "PathAlreadyExists", "ConditionNotMet":
+		return os.ErrExist
+	case "AuthenticationFailed", "AuthorizationFailure", "AuthorizationPermissionMismatch",
+		"InsufficientAccountPermissions":
+		return os.ErrPermission
+	case "DirectoryNotEmpty":
+		return syscall.ENOTEMPTY
+	case "InvalidRange", "InvalidFlushPosition", "InvalidSourceOrDestinationResourceType":
+		return os.ErrInvalid
+	case "FeatureNotSupported", "NotImplemented":
+		return extfs.ErrUnsupported
+	}
+
+	switch azErr.StatusCode {
+	case http.StatusNotFound:
+		return os.ErrNotExist
+	case http.StatusForbidden:
+		return os.ErrPermission
+	case http.StatusPreconditionFailed:
+		return os.ErrExist
+	default:
+		return err
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+var errClosed = errors.New("Azure file already closed")
+
+// blockSize is the size of the blocks the written data is sent in.
+var blockSize = 4 * 1024 * 1024
+
+// uploader sends the content written to a file. The blobs are uploaded in
+// blocks, the Data Lake files are appended to.
+type uploader interface {
+	// upload sends a full block.
+	upload(data []byte) error
+
+	// commit sends the last data and makes the content visible.
+	commit(data []byte) error
+
+	// abort discards the blocks sent.
+	abort()
+}
+
+// stater returns the info of a path of a filesystem.
+type stater interface {
+	stat(fullpath string) (os.FileInfo, error)
+}
+
+// file is a blob or a Data Lake file opened either for reading or for
+// writing. A reader sends a ranged GET from its offset on the first read.
+// A writer buffers the data and sends it in blocks.
+type file struct {
+	fs       stater
+	client   *client
+	name     string
+	fullpath string
+	key      string
+	closed   bool
+
+	// Readers
+	reader *util.RangeReader
+
+	// Writers
+	buf    []byte
+	upload uploader
+	err    error
+}
+
+func newReader(fs stater, c *client, name, fullpath string, size int64) *file {
+	key := pathKey(fullpath)
+	open := func(off, length int64) (io.ReadCloser, error) {
+		body, err := c.get(key, off, length)
+		if err != nil {
+			return nil, &os.PathError{Op: "read", Path: fullpath, Err: interpretError(err)}
+		}
+		return body, nil
+	}
+
+	return &file{fs: fs, client: c, name: name, fullpath: fullpath, key: key, reader: &util.RangeReader{Path: fullpath, Size: size, Open: open}}
+}
+
+func newWriter(fs stater, c *client, name, fullpath string, upload uploader) *file {
+	return &file{fs: fs, client: c, name: name, fullpath: fullpath, key: pathKey(fullpath), upload: upload}
+}
+
+func (f *file) Close() error {
+	if f.closed {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: errClosed}
+	}
+	f.closed = true
+
+	if f.reader != nil {
+		return f.reader.Close()
+	}
+
+	if f.err != nil {
+		return f.err
+	}
+	if err := f.upload.commit(f.buf); err != nil {
+		f.upload.abort()
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Read(p)
+}
+
+func (f *file) ReadAt(p []byte, off int64) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.ReadAt(p, off)
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrUnsupported
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "seek", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	if f.reader != nil {
+		return 0, extfs.ErrReadOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "write", Path: f.fullpath, Err: errClosed}
+	}
+	if f.err != nil {
+		return 0, f.err
+	}
+
+	f.buf = append(f.buf, p...)
+	for len(f.buf) >= blockSize {
+		if err := f.upload.upload(f.buf[:blockSize]); err != nil {
+			f.upload.abort()
+			f.err = &os.PathError{Op: "write", Path: f.fullpath, Err: interpretError(err)}
+			return 0, f.err
+		}
+		f.buf = append(f.buf[:0], f.buf[blockSize:]...)
+	}
+
+	return len(p), nil
+}
+
+func (f *file) WriteAt(p []byte, off int64) (int, error) {
+	return 0, extfs.ErrUnsupported
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+func (f *file) Stat() (os.FileInfo, error) {
+	return f.fs.stat(f.fullpath)
+}
+
+func (f *file) Sync() error {
+	if f.reader == nil {
+		return extfs.ErrUnsupported
+	}
+
+	return nil
+}
+
+func (f *file) Truncate(size int64) error {
+	return extfs.ErrUnsupported
+}
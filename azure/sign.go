@@ -0,0 +1,112 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// signedHeaders are the standard headers of the string to sign, in order.
+var signedHeaders = []string{
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Length",
+	"Content-Md5",
+	"Content-Type",
+	"Date",
+	"If-Modified-Since",
+	"If-Match",
+	"If-None-Match",
+	"If-Unmodified-Since",
+	"Range",
+}
+
+// sharedKey signs the requests with the key of a storage account.
+type sharedKey struct {
+	account string
+	key     []byte
+}
+
+// sign adds the authorization of a request, whose x-ms-date header is set.
+// The URL of the request must already be escaped with uriEncode, since the
+// canonical resource uses its path as is.
+func (k *sharedKey) sign(req *http.Request) {
+	req.Header.Set("Authorization", "SharedKey "+k.account+":"+k.signature(req))
+}
+
+func (k *sharedKey) signature(req *http.Request) string {
+	var b strings.Builder
+	b.WriteString(req.Method + "\n")
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "Content-Length" {
+			value = ""
+			if req.ContentLength > 0 {
+				value = strconv.FormatInt(req.ContentLength, 10)
+			}
+		}
+		b.WriteString(value + "\n")
+	}
+
+	// The canonicalized headers and resource
+	header := req.Header
+	var names []string
+	for name := range header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			names = append(names, lower)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString(name + ":" + strings.TrimSpace(strings.Join(header.Values(name), ",")) + "\n")
+	}
+
+	b.WriteString("/" + k.account + req.URL.EscapedPath())
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for name := range query {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	for _, name := range params {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		b.WriteString("\n" + strings.ToLower(name) + ":" + strings.Join(values, ","))
+	}
+
+	h := hmac.New(sha256.New, k.key)
+	h.Write([]byte(b.String()))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// uriEncode escapes every byte except the unreserved characters and the
+// slashes.
+func uriEncode(s string) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hexDigits[c>>4])
+			b.WriteByte(hexDigits[c&15])
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,142 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Properties are the properties of a blob or of a path. They are the Sys()
+// of the os.FileInfo of the files, and of the directories which have a
+// blob or a path of their own.
+type Properties struct {
+	Name          string
+	Directory     bool
+	ContentLength int64
+	LastModified  time.Time
+	ETag          string
+	ContentType   string
+
+	// Owner, Group and Permissions are set by the Data Lake service only,
+	// the permissions in their symbolic form, such as rwxr-x---.
+	Owner       string
+	Group       string
+	Permissions string
+}
+
+// headerProperties returns the properties in the header of the response
+// to a HEAD request.
+func headerProperties(name string, header http.Header) *Properties {
+	p := &Properties{
+		Name:        name,
+		ETag:        header.Get("ETag"),
+		ContentType: header.Get("Content-Type"),
+		Owner:       header.Get("X-Ms-Owner"),
+		Group:       header.Get("X-Ms-Group"),
+		Permissions: header.Get("X-Ms-Permissions"),
+		Directory: header.Get("X-Ms-Resource-Type") == "directory" ||
+			header.Get("X-Ms-Meta-Hdi_isfolder") == "true",
+	}
+	p.ContentLength, _ = strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	if t, err := http.ParseTime(header.Get("Last-Modified")); err == nil {
+		p.LastModified = t
+	}
+
+	return p
+}
+
+// fileInfo describes a file, or a directory which may have no properties
+// when its blobs only share a prefix.
+type fileInfo struct {
+	name  string
+	dir   bool
+	props *Properties
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	if fi.dir || fi.props == nil {
+		return 0
+	}
+
+	return fi.props.ContentLength
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	mode := os.FileMode(0644)
+	if fi.dir {
+		mode = os.ModeDir | 0755
+	}
+	if fi.props != nil && fi.props.Permissions != "" {
+		if perm, ok := parsePermissions(fi.props.Permissions); ok {
+			mode = mode&os.ModeDir | perm
+		}
+	}
+
+	return mode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	if fi.props == nil {
+		return time.Time{}
+	}
+
+	return fi.props.LastModified
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.dir
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return fi.props
+}
+
+// parsePermissions parses symbolic permissions, such as rwxr-x--T. A
+// trailing + marks the paths which have an access control list.
+func parsePermissions(s string) (os.FileMode, bool) {
+	if len(s) == 10 && s[9] == '+' {
+		s = s[:9]
+	}
+	if len(s) != 9 {
+		return 0, false
+	}
+
+	var mode os.FileMode
+	for i := 0; i < 9; i++ {
+		switch c := s[i]; {
+		case c == "rwxrwxrwx"[i]:
+			mode |= 1 << uint(8-i)
+		case i == 8 && c == 't':
+			mode |= 1 | os.ModeSticky
+		case i == 8 && c == 'T':
+			mode |= os.ModeSticky
+		case c != '-':
+			return 0, false
+		}
+	}
+
+	return mode, true
+}
+
+// formatPermissions returns the octal form of the permissions, which the
+// Data Lake service accepts as well.
+func formatPermissions(mode os.FileMode) string {
+	perm := uint32(mode.Perm())
+	if mode&os.ModeSticky != 0 {
+		perm |= 01000
+	}
+
+	return fmt.Sprintf("%04o", perm)
+}
@@ -0,0 +1,27 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+// BlockLocation describes where a block of a file is stored.
+type BlockLocation struct {
+	// Offset is the offset of the block in the file.
+	Offset int64
+
+	// Length is the size of the block.
+	Length int64
+
+	// Hosts are the hostnames of the nodes holding a replica of the block.
+	Hosts []string
+}
+
+// BlockLocator is implemented by the filesystems which can tell where the
+// blocks of a file are stored, for data-locality scheduling.
+type BlockLocator interface {
+	// BlockLocations returns the blocks of the named file which overlap the
+	// given byte range.
+	BlockLocations(path string, offset, length int64) ([]BlockLocation, error)
+}
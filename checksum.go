@@ -0,0 +1,173 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// ChecksumAlgorithm names the algorithm of a file checksum.
+type ChecksumAlgorithm string
+
+// Checksum algorithms
+const (
+	// MD5MD5CRC32C is the native file checksum of HDFS: the MD5 of the MD5s
+	// of the CRC32C of every 512 bytes chunk of each block.
+	MD5MD5CRC32C ChecksumAlgorithm = "MD5-of-MD5-of-CRC32C"
+	SHA256       ChecksumAlgorithm = "SHA-256"
+	CRC32C       ChecksumAlgorithm = "CRC32C"
+)
+
+const (
+	// DefaultBlockSize is the default block size of HDFS, which the
+	// MD5MD5CRC32C checksums depend on.
+	DefaultBlockSize = 128 * 1024 * 1024
+
+	bytesPerCRC = 512
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// FileChecksum is the checksum of the content of a file.
+type FileChecksum struct {
+	Algorithm ChecksumAlgorithm
+	Sum       []byte
+}
+
+func (c *FileChecksum) String() string {
+	return string(c.Algorithm) + ":" + hex.EncodeToString(c.Sum)
+}
+
+// Checksummer is implemented by the filesystems which can compute the
+// checksum of a file.
+type Checksummer interface {
+	// Checksum returns the checksum of the named file with the given
+	// algorithm.
+	Checksum(path string, algorithm ChecksumAlgorithm) (*FileChecksum, error)
+}
+
+// ComputeChecksum computes the checksum of the content read from r. The
+// MD5MD5CRC32C checksum is only equal to the one of HDFS if blockSize is the
+// block size of the HDFS file.
+func ComputeChecksum(r io.Reader, algorithm ChecksumAlgorithm, blockSize int64) (*FileChecksum, error) {
+	var h hash.Hash
+	switch algorithm {
+	case MD5MD5CRC32C:
+		h = newMD5MD5CRC32C(blockSize)
+	case SHA256:
+		h = sha256.New()
+	case CRC32C:
+		h = crc32.New(castagnoli)
+	default:
+		return nil, ErrUnsupported
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+
+	return &FileChecksum{Algorithm: algorithm, Sum: h.Sum(nil)}, nil
+}
+
+// md5md5crc32c computes the MD5MD5CRC32C checksum of a stream.
+type md5md5crc32c struct {
+	blockSize int64
+	written   int64
+
+	// chunk is the current chunk, crcs the checksums of the complete chunks
+	// of the current block and md5s the MD5s of the complete blocks.
+	chunk []byte
+	crcs  []byte
+	md5s  []byte
+}
+
+func newMD5MD5CRC32C(blockSize int64) *md5md5crc32c {
+	if blockSize <= 0 {
+		blockSize = DefaultBlockSize
+	}
+
+	return &md5md5crc32c{blockSize: blockSize, chunk: make([]byte, 0, bytesPerCRC)}
+}
+
+func (h *md5md5crc32c) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// Chunks never span two blocks.
+		room := int64(bytesPerCRC - len(h.chunk))
+		if left := h.blockSize - h.written%h.blockSize; left < room {
+			room = left
+		}
+		if int64(len(p)) < room {
+			room = int64(len(p))
+		}
+
+		h.chunk = append(h.chunk, p[:room]...)
+		h.written += room
+		p = p[room:]
+
+		endOfBlock := h.written%h.blockSize == 0
+		if len(h.chunk) == bytesPerCRC || endOfBlock {
+			h.crcs = appendCRC(h.crcs, h.chunk)
+			h.chunk = h.chunk[:0]
+		}
+		if endOfBlock {
+			sum := md5.Sum(h.crcs)
+			h.md5s = append(h.md5s, sum[:]...)
+			h.crcs = h.crcs[:0]
+		}
+	}
+
+	return n, nil
+}
+
+// Sum appends the checksum to b. Like hadoop, the block MD5s are padded with
+// zeroes to the next power of two, with a minimum of 32 bytes.
+func (h *md5md5crc32c) Sum(b []byte) []byte {
+	md5s := append([]byte(nil), h.md5s...)
+
+	crcs := append([]byte(nil), h.crcs...)
+	if len(h.chunk) > 0 {
+		crcs = appendCRC(crcs, h.chunk)
+	}
+	if len(crcs) > 0 {
+		sum := md5.Sum(crcs)
+		md5s = append(md5s, sum[:]...)
+	}
+
+	padded := 32
+	for padded < len(md5s) {
+		padded *= 2
+	}
+	md5s = append(md5s, make([]byte, padded-len(md5s))...)
+
+	sum := md5.Sum(md5s)
+	return append(b, sum[:]...)
+}
+
+func (h *md5md5crc32c) Reset() {
+	*h = *newMD5MD5CRC32C(h.blockSize)
+}
+
+func (h *md5md5crc32c) Size() int {
+	return md5.Size
+}
+
+func (h *md5md5crc32c) BlockSize() int {
+	return bytesPerCRC
+}
+
+func appendCRC(b []byte, chunk []byte) []byte {
+	var crc [4]byte
+	binary.BigEndian.PutUint32(crc[:], crc32.Checksum(chunk, castagnoli))
+	return append(b, crc[:]...)
+}
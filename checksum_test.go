@@ -0,0 +1,57 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+import (
+	"bytes"
+	"crypto/md5"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMD5MD5CRC32C(t *testing.T) {
+	data := make([]byte, 1500)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	blockMD5 := func(chunks ...[]byte) []byte {
+		var crcs []byte
+		for _, c := range chunks {
+			crcs = appendCRC(crcs, c)
+		}
+		sum := md5.Sum(crcs)
+		return sum[:]
+	}
+	md5s := append(blockMD5(data[:512], data[512:1024]), blockMD5(data[1024:])...)
+	expected := md5.Sum(md5s)
+
+	cs, err := ComputeChecksum(bytes.NewReader(data), MD5MD5CRC32C, 1024)
+	require.NoError(t, err)
+	assert.Equal(t, expected[:], cs.Sum)
+
+	h := newMD5MD5CRC32C(1024)
+	for _, b := range data {
+		h.Write([]byte{b})
+	}
+	assert.Equal(t, expected[:], h.Sum(nil))
+}
+
+func TestComputeChecksum(t *testing.T) {
+	cs, err := ComputeChecksum(bytes.NewReader([]byte("Hello world")), SHA256, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "SHA-256:64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", cs.String())
+
+	cs, err = ComputeChecksum(bytes.NewReader([]byte("Hello world")), CRC32C, 0)
+	require.NoError(t, err)
+	assert.Equal(t, appendCRC(nil, []byte("Hello world")), cs.Sum)
+
+	_, err = ComputeChecksum(bytes.NewReader(nil), "MD5", 0)
+	assert.Equal(t, ErrUnsupported, err)
+}
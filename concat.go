@@ -0,0 +1,15 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+// Concatenator is implemented by the filesystems which can assemble a file
+// from parts.
+type Concatenator interface {
+	// Concat appends the content of the sources to the target file, in
+	// order, and removes the sources.
+	Concat(target string, sources []string) error
+}
@@ -6,11 +6,30 @@ SPDX-License-Identifier: Apache-2.0
 
 package extfs
 
+import (
+	"crypto/tls"
+	"time"
+)
+
+// Protection levels of the HDFS connections. Each level implies the
+// previous ones.
+const (
+	ProtectionAuthentication = "authentication"
+	ProtectionIntegrity      = "integrity"
+	ProtectionPrivacy        = "privacy"
+)
+
 // Config epresents the configurable options for a filesystem.
 type Config struct {
-	// User specifies which HDFS user the client will act as. HDFS only
+	// User specifies which HDFS user the client will act as, or the user
+	// SFTP, FTP, WebDAV and HTTP log in as. HDFS, WebHDFS, SFTP, FTP, WebDAV
+	// and HTTP
 	User string
 
+	// Password specifies the password of the user. SFTP, FTP, WebDAV and
+	// HTTP
+	Password string
+
 	// Addresses specifies the namenode(s) to connect to. HDFS only
 	Addresses []string
 
@@ -18,6 +37,167 @@ type Config struct {
 	// datanodes via hostname (which is useful in multi-homed setups) or IP
 	// address
 	UseDatanodeHostname bool
+
+	// Trash specifies whether the removed files are moved to a trash
+	// directory instead of being deleted
+	Trash bool
+
+	// TrashDir specifies the trash directory, relative to the root of the
+	// filesystem. It defaults to .Trash/<user>
+	TrashDir string
+
+	// KerberosPrincipal specifies the principal the client authenticates as,
+	// in the form name@REALM. The realm defaults to the default_realm of the
+	// kerberos configuration. HDFS only
+	KerberosPrincipal string
+
+	// KerberosKeytab specifies the keytab used to log in. If the principal is
+	// empty, the first principal of the keytab is used. HDFS only
+	KerberosKeytab string
+
+	// KerberosCCache specifies the credential cache used to log in when no
+	// keytab is given, for example one filled by kinit. HDFS only
+	KerberosCCache string
+
+	// KerberosConfig specifies the path of the krb5.conf file. It defaults to
+	// $KRB5_CONFIG or /etc/krb5.conf. HDFS only
+	KerberosConfig string
+
+	// KerberosServicePrincipalName specifies the service principal name of
+	// the namenode, for example nn/_HOST. It overrides the
+	// dfs.namenode.kerberos.principal property of the hadoop configuration.
+	// HDFS only
+	KerberosServicePrincipalName string
+
+	// DataTransferProtection specifies the protection of the connections to
+	// the datanodes: authentication, integrity or privacy. It overrides the
+	// dfs.data.transfer.protection property of the hadoop configuration.
+	// HDFS only
+	DataTransferProtection string
+
+	// RPCProtection specifies the protection of the connection to the
+	// namenode: authentication, integrity or privacy. The namenode must offer
+	// it in its hadoop.rpc.protection. Integrity and privacy require kerberos.
+	// HDFS only
+	RPCProtection string
+
+	// HadoopConfDir specifies the directory of the core-site.xml and
+	// hdfs-site.xml files. When set, HADOOP_CONF_DIR and HADOOP_HOME are
+	// ignored. HDFS only
+	HadoopConfDir string
+
+	// HadoopConf specifies hadoop configuration properties, which override
+	// the ones of the configuration files. HDFS only
+	HadoopConf map[string]string
+
+	// DisableHadoopEnv disables the loading of the hadoop configuration from
+	// HADOOP_CONF_DIR and HADOOP_HOME. HDFS only
+	DisableHadoopEnv bool
+
+	// DialTimeout specifies the timeout of the connections to the namenodes
+	// and the datanodes, to the S3 or Azure endpoint, to the FTP, WebDAV or
+	// HTTP server, or of the SSH handshake. Zero means no timeout. HDFS,
+	// WebHDFS, S3, SFTP, FTP, WebDAV, HTTP and Azure
+	DialTimeout time.Duration
+
+	// RPCTimeout specifies how long the client waits for the response of a
+	// namenode call, for the response headers of a WebHDFS, S3, WebDAV, HTTP
+	// or Azure request, or for the reply of a FTP command. Zero means no
+	// timeout. HDFS, WebHDFS, S3, FTP, WebDAV, HTTP and Azure
+	RPCTimeout time.Duration
+
+	// MaxRetries specifies how many times a failed operation is retried.
+	// The operations which modify the filesystem are only retried when
+	// their request was not sent or was refused. HDFS only
+	MaxRetries int
+
+	// RetryBackoff specifies the wait before the first retry, doubled after
+	// each attempt. It defaults to one second. HDFS only
+	RetryBackoff time.Duration
+
+	// Retryable reports whether an operation which failed with err is
+	// retried. It defaults to hdfs.IsRetryable. HDFS only
+	Retryable func(err error) bool
+
+	// DelegationToken specifies the delegation token sent instead of the
+	// user name, in its URL-safe encoding. WebHDFS only
+	DelegationToken string
+
+	// TLSConfig specifies the TLS configuration of the swebhdfs, S3, ftps,
+	// webdavs, https, abfss and wasbs connections. WebHDFS, S3, FTP, WebDAV,
+	// HTTP and Azure
+	TLSConfig *tls.Config
+
+	// S3Endpoint specifies the URL of the S3 service, for the S3 compatible
+	// stores. It defaults to the AWS endpoint of the region. S3 only
+	S3Endpoint string
+
+	// S3Region specifies the region of the bucket. It defaults to
+	// $AWS_REGION, $AWS_DEFAULT_REGION or us-east-1. S3 only
+	S3Region string
+
+	// S3AccessKeyID, S3SecretAccessKey and S3SessionToken specify the
+	// credentials which sign the requests. They default to
+	// $AWS_ACCESS_KEY_ID, $AWS_SECRET_ACCESS_KEY and $AWS_SESSION_TOKEN.
+	// Without an access key the requests are anonymous. S3 only
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3SessionToken    string
+
+	// S3PathStyle specifies whether the bucket is addressed in the path of
+	// the URLs instead of in the host name. S3 only
+	S3PathStyle bool
+
+	// S3PartSize specifies the size of the parts of the multipart uploads.
+	// It defaults to 5 MiB, the minimum of AWS. S3 only
+	S3PartSize int64
+
+	// SSHPrivateKey specifies a PEM encoded private key the client
+	// authenticates with. SFTP only
+	SSHPrivateKey []byte
+
+	// SSHPrivateKeyFile specifies the path of a private key the client
+	// authenticates with, such as ~/.ssh/id_ed25519. SFTP only
+	SSHPrivateKeyFile string
+
+	// SSHPrivateKeyPassphrase specifies the passphrase of an encrypted
+	// private key. SFTP only
+	SSHPrivateKeyPassphrase string
+
+	// SSHKnownHostsFile specifies the known_hosts file the host key of the
+	// server is verified against. It defaults to ~/.ssh/known_hosts. SFTP
+	// only
+	SSHKnownHostsFile string
+
+	// SSHInsecureIgnoreHostKey disables the verification of the host key of
+	// the server. SFTP only
+	SSHInsecureIgnoreHostKey bool
+
+	// FTPImplicitTLS specifies whether the ftps connections start with TLS
+	// instead of negotiating it with AUTH TLS. FTP only
+	FTPImplicitTLS bool
+
+	// HTTPAutoindex specifies whether ReadDir parses the index pages the
+	// servers generate for the directories, such as the autoindex of nginx.
+	// HTTP only
+	HTTPAutoindex bool
+
+	// AzureEndpoint specifies the URL of the storage service, which
+	// replaces the one of the account in the abfs and wasb URLs, such as
+	// http://127.0.0.1:10000/devstoreaccount1 for an emulator. The account
+	// is the first segment of its path, if any. Azure only
+	AzureEndpoint string
+
+	// AzureAccountKey specifies the shared key of the storage account,
+	// encoded in base64, which signs the requests. It defaults to
+	// $AZURE_STORAGE_KEY. Azure only
+	AzureAccountKey string
+
+	// AzureSASToken specifies a shared access signature added to the
+	// requests instead of signing them. It defaults to
+	// $AZURE_STORAGE_SAS_TOKEN. Without a key nor a token the requests are
+	// anonymous. Azure only
+	AzureSASToken string
 }
 
 // ClientOption func for each Config argument
@@ -31,7 +211,7 @@ func WithAddresses(addrs []string) ClientOption {
 	}
 }
 
-// WithUser option to configure hdfs user
+// WithUser option to configure hdfs, sftp, ftp, webdav or http user
 func WithUser(user string) ClientOption {
 	return func(cfg *Config) error {
 		cfg.User = user
@@ -39,6 +219,14 @@ func WithUser(user string) ClientOption {
 	}
 }
 
+// WithPassword option to configure the sftp, ftp, webdav or http password
+func WithPassword(password string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.Password = password
+		return nil
+	}
+}
+
 // WithUseDatanodeHostname option to configure use datanode hostname
 func WithUseDatanodeHostname(use bool) ClientOption {
 	return func(cfg *Config) error {
@@ -46,3 +234,262 @@ func WithUseDatanodeHostname(use bool) ClientOption {
 		return nil
 	}
 }
+
+// WithTrash option to configure whether the removed files are moved to the trash
+func WithTrash(enabled bool) ClientOption {
+	return func(cfg *Config) error {
+		cfg.Trash = enabled
+		return nil
+	}
+}
+
+// WithTrashDir option to configure the trash directory
+func WithTrashDir(dir string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.TrashDir = dir
+		return nil
+	}
+}
+
+// WithKerberosKeytab option to configure hdfs kerberos login with a keytab
+func WithKerberosKeytab(principal, keytab string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.KerberosPrincipal = principal
+		cfg.KerberosKeytab = keytab
+		return nil
+	}
+}
+
+// WithKerberosCCache option to configure hdfs kerberos login with a credential cache
+func WithKerberosCCache(ccache string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.KerberosCCache = ccache
+		return nil
+	}
+}
+
+// WithKerberosConfig option to configure the krb5.conf path
+func WithKerberosConfig(path string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.KerberosConfig = path
+		return nil
+	}
+}
+
+// WithKerberosServicePrincipalName option to configure the namenode service principal name
+func WithKerberosServicePrincipalName(spn string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.KerberosServicePrincipalName = spn
+		return nil
+	}
+}
+
+// WithDataTransferProtection option to configure the protection of the hdfs datanode connections
+func WithDataTransferProtection(protection string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.DataTransferProtection = protection
+		return nil
+	}
+}
+
+// WithRPCProtection option to configure the protection of the hdfs namenode connection
+func WithRPCProtection(protection string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.RPCProtection = protection
+		return nil
+	}
+}
+
+// WithHadoopConfDir option to configure the hadoop configuration directory
+func WithHadoopConfDir(dir string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.HadoopConfDir = dir
+		return nil
+	}
+}
+
+// WithHadoopConf option to configure hadoop configuration properties
+func WithHadoopConf(props map[string]string) ClientOption {
+	return func(cfg *Config) error {
+		if cfg.HadoopConf == nil {
+			cfg.HadoopConf = make(map[string]string, len(props))
+		}
+		for k, v := range props {
+			cfg.HadoopConf[k] = v
+		}
+		return nil
+	}
+}
+
+// WithDisableHadoopEnv option to configure whether the hadoop configuration is loaded from the environment
+func WithDisableHadoopEnv(disable bool) ClientOption {
+	return func(cfg *Config) error {
+		cfg.DisableHadoopEnv = disable
+		return nil
+	}
+}
+
+// WithDialTimeout option to configure the hdfs, webhdfs, s3, sftp, ftp, webdav, http and azure connection timeout
+func WithDialTimeout(timeout time.Duration) ClientOption {
+	return func(cfg *Config) error {
+		cfg.DialTimeout = timeout
+		return nil
+	}
+}
+
+// WithRPCTimeout option to configure the hdfs namenode call, http response and ftp reply timeout
+func WithRPCTimeout(timeout time.Duration) ClientOption {
+	return func(cfg *Config) error {
+		cfg.RPCTimeout = timeout
+		return nil
+	}
+}
+
+// WithRetries option to configure the retries of the failed hdfs operations
+func WithRetries(maxRetries int, backoff time.Duration) ClientOption {
+	return func(cfg *Config) error {
+		cfg.MaxRetries = maxRetries
+		cfg.RetryBackoff = backoff
+		return nil
+	}
+}
+
+// WithRetryable option to configure which hdfs errors are retried
+func WithRetryable(retryable func(err error) bool) ClientOption {
+	return func(cfg *Config) error {
+		cfg.Retryable = retryable
+		return nil
+	}
+}
+
+// WithDelegationToken option to configure the webhdfs delegation token
+func WithDelegationToken(token string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.DelegationToken = token
+		return nil
+	}
+}
+
+// WithTLSConfig option to configure the swebhdfs, s3, ftps, webdavs, https, abfss and wasbs TLS connections
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	return func(cfg *Config) error {
+		cfg.TLSConfig = tlsConfig
+		return nil
+	}
+}
+
+// WithS3Endpoint option to configure the s3 service endpoint
+func WithS3Endpoint(endpoint string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.S3Endpoint = endpoint
+		return nil
+	}
+}
+
+// WithS3Region option to configure the s3 bucket region
+func WithS3Region(region string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.S3Region = region
+		return nil
+	}
+}
+
+// WithS3Credentials option to configure the s3 credentials
+func WithS3Credentials(accessKeyID, secretAccessKey, sessionToken string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.S3AccessKeyID = accessKeyID
+		cfg.S3SecretAccessKey = secretAccessKey
+		cfg.S3SessionToken = sessionToken
+		return nil
+	}
+}
+
+// WithS3PathStyle option to configure the s3 path style addressing
+func WithS3PathStyle(pathStyle bool) ClientOption {
+	return func(cfg *Config) error {
+		cfg.S3PathStyle = pathStyle
+		return nil
+	}
+}
+
+// WithS3PartSize option to configure the s3 multipart upload part size
+func WithS3PartSize(size int64) ClientOption {
+	return func(cfg *Config) error {
+		cfg.S3PartSize = size
+		return nil
+	}
+}
+
+// WithSSHPrivateKey option to configure the sftp private key
+func WithSSHPrivateKey(key []byte, passphrase string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.SSHPrivateKey = key
+		cfg.SSHPrivateKeyPassphrase = passphrase
+		return nil
+	}
+}
+
+// WithSSHPrivateKeyFile option to configure the sftp private key file
+func WithSSHPrivateKeyFile(path, passphrase string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.SSHPrivateKeyFile = path
+		cfg.SSHPrivateKeyPassphrase = passphrase
+		return nil
+	}
+}
+
+// WithSSHKnownHostsFile option to configure the sftp known_hosts file
+func WithSSHKnownHostsFile(path string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.SSHKnownHostsFile = path
+		return nil
+	}
+}
+
+// WithSSHInsecureIgnoreHostKey option to configure whether the sftp host key is not verified
+func WithSSHInsecureIgnoreHostKey(ignore bool) ClientOption {
+	return func(cfg *Config) error {
+		cfg.SSHInsecureIgnoreHostKey = ignore
+		return nil
+	}
+}
+
+// WithFTPImplicitTLS option to configure whether the ftps connections use implicit TLS
+func WithFTPImplicitTLS(implicit bool) ClientOption {
+	return func(cfg *Config) error {
+		cfg.FTPImplicitTLS = implicit
+		return nil
+	}
+}
+
+// WithHTTPAutoindex option to configure whether the http directories are listed from their index pages
+func WithHTTPAutoindex(autoindex bool) ClientOption {
+	return func(cfg *Config) error {
+		cfg.HTTPAutoindex = autoindex
+		return nil
+	}
+}
+
+// WithAzureEndpoint option to configure the azure storage service endpoint
+func WithAzureEndpoint(endpoint string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.AzureEndpoint = endpoint
+		return nil
+	}
+}
+
+// WithAzureAccountKey option to configure the azure storage account shared key
+func WithAzureAccountKey(key string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.AzureAccountKey = key
+		return nil
+	}
+}
+
+// WithAzureSASToken option to configure the azure shared access signature
+func WithAzureSASToken(token string) ClientOption {
+	return func(cfg *Config) error {
+		cfg.AzureSASToken = token
+		return nil
+	}
+}
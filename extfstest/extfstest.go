@@ -0,0 +1,266 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package extfstest checks the behaviour shared by the filesystems of the
+// backends. Every backend runs the checks against its own fake server and
+// keeps only its specific cases in its tests.
+package extfstest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Config describes the filesystems under test.
+type Config struct {
+	// New returns a filesystem rooted at base.
+	New func(t *testing.T, base string) extfs.Filesystem
+
+	// Append reports whether a file can be opened with os.O_APPEND.
+	Append bool
+
+	// RandomAccess reports whether the files are read and written by the
+	// server, which rejects the reads of a write-only file and the writes of
+	// a read-only file with its own errors.
+	RandomAccess bool
+}
+
+// Main starts the fake server of a backend, runs the tests of the package
+// and stops the server. start returns the function which stops the server.
+func Main(m *testing.M, start func() (stop func(), err error)) {
+	stop, err := start()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+// WriteFile creates the named file with the data.
+func WriteFile(t *testing.T, fs extfs.Filesystem, name string, data []byte) {
+	f, err := fs.Create(name)
+	require.NoError(t, err)
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+// ReadFile returns the content of the named file.
+func ReadFile(t *testing.T, fs extfs.Filesystem, name string) []byte {
+	f, err := fs.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	data, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	return data
+}
+
+// Test runs the checks on the filesystems returned by c.New, each one in a
+// subtest with its own base under /extfstest.
+func Test(t *testing.T, c Config) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, fs extfs.Filesystem, c Config)
+	}{
+		{"Create", testCreate},
+		{"Read", testRead},
+		{"OpenFile", testOpenFile},
+		{"Directories", testDirectories},
+		{"Rename", testRename},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := c.New(t, "/extfstest/"+tt.name)
+			defer fs.Close()
+			tt.test(t, fs, c)
+		})
+	}
+}
+
+// TestRead checks the reads of the named file, which contains "Hello world".
+func TestRead(t *testing.T, fs extfs.Filesystem, name string) {
+	f, err := fs.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(f, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", string(buf))
+
+	pos, err := f.Seek(-5, io.SeekEnd)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), pos)
+	data, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(data))
+
+	buf = make([]byte, 3)
+	n, err := f.ReadAt(buf, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "llo", string(buf[:n]))
+
+	buf = make([]byte, 10)
+	n, err = f.ReadAt(buf, 6)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "world", string(buf[:n]))
+
+	_, err = f.ReadAt(buf, 11)
+	assert.Equal(t, io.EOF, err)
+}
+
+func testCreate(t *testing.T, fs extfs.Filesystem, c Config) {
+	WriteFile(t, fs, "dir/my file.txt", []byte("Hello world"))
+	assert.Equal(t, "Hello world", string(ReadFile(t, fs, "dir/my file.txt")))
+
+	fi, err := fs.Stat("dir/my file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "my file.txt", fi.Name())
+	assert.Equal(t, int64(11), fi.Size())
+	assert.False(t, fi.IsDir())
+
+	fi, err = fs.Stat("dir")
+	require.NoError(t, err)
+	assert.True(t, fi.IsDir())
+
+	WriteFile(t, fs, "empty.txt", nil)
+	assert.Empty(t, ReadFile(t, fs, "empty.txt"))
+
+	_, err = fs.Stat("dir/missing.txt")
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = fs.Create("../escape.txt")
+	assert.Equal(t, extfs.ErrCrossedBoundary, err)
+}
+
+func testRead(t *testing.T, fs extfs.Filesystem, c Config) {
+	WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+	TestRead(t, fs, "myfile.txt")
+
+	f, err := fs.Open("myfile.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("x"))
+	checkModeError(t, c, extfs.ErrReadOnly, err)
+	require.NoError(t, f.Close())
+
+	_, err = fs.Open("missing.txt")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func testOpenFile(t *testing.T, fs extfs.Filesystem, c Config) {
+	_, err := fs.OpenFile("myfile.txt", os.O_WRONLY, 0)
+	assert.True(t, os.IsNotExist(err))
+
+	f, err := fs.OpenFile("myfile.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	require.NoError(t, err)
+	_, err = f.Read(make([]byte, 1))
+	checkModeError(t, c, extfs.ErrWriteOnly, err)
+	_, err = f.Write([]byte("Hello"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = fs.OpenFile("myfile.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	assert.True(t, os.IsExist(err))
+
+	f, err = fs.OpenFile("myfile.txt", os.O_WRONLY|os.O_APPEND, 0)
+	if c.Append {
+		require.NoError(t, err)
+		_, err = f.Write([]byte(" world"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		assert.Equal(t, "Hello world", string(ReadFile(t, fs, "myfile.txt")))
+	} else {
+		assert.True(t, errors.Is(err, extfs.ErrUnsupported))
+	}
+
+	f, err = fs.OpenFile("myfile.txt", os.O_WRONLY|os.O_TRUNC, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("replaced"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Equal(t, "replaced", string(ReadFile(t, fs, "myfile.txt")))
+}
+
+func testDirectories(t *testing.T, fs extfs.Filesystem, c Config) {
+	require.NoError(t, fs.MkdirAll("a/b/c", 0755))
+	require.NoError(t, fs.MkdirAll("a/b", 0755))
+	fi, err := fs.Stat("a/b/c")
+	require.NoError(t, err)
+	assert.True(t, fi.IsDir())
+
+	WriteFile(t, fs, "a/z.txt", []byte("z"))
+	WriteFile(t, fs, "a/m.txt", []byte("m"))
+	assert.Error(t, fs.MkdirAll("a/z.txt/d", 0755))
+
+	fis, err := fs.ReadDir("a")
+	require.NoError(t, err)
+	var names []string
+	for _, fi := range fis {
+		names = append(names, fi.Name())
+	}
+	require.Equal(t, []string{"b", "m.txt", "z.txt"}, names)
+	assert.True(t, fis[0].IsDir())
+	assert.Equal(t, int64(1), fis[1].Size())
+
+	_, err = fs.ReadDir("missing")
+	assert.True(t, os.IsNotExist(err))
+
+	assert.Error(t, fs.Remove("a/b"))
+	require.NoError(t, fs.Remove("a/b/c"))
+	require.NoError(t, fs.Remove("a/m.txt"))
+	_, err = fs.Stat("a/m.txt")
+	assert.True(t, os.IsNotExist(err))
+
+	err = fs.Remove("a/m.txt")
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, fs.RemoveAll("a"))
+	_, err = fs.Stat("a")
+	assert.True(t, os.IsNotExist(err))
+
+	assert.NoError(t, fs.RemoveAll("missing"))
+}
+
+func testRename(t *testing.T, fs extfs.Filesystem, c Config) {
+	WriteFile(t, fs, "src.txt", []byte("source"))
+	WriteFile(t, fs, "dst.txt", []byte("destination"))
+
+	require.NoError(t, fs.Rename("src.txt", "dst.txt"))
+	assert.Equal(t, "source", string(ReadFile(t, fs, "dst.txt")))
+	_, err := fs.Stat("src.txt")
+	assert.True(t, os.IsNotExist(err))
+
+	WriteFile(t, fs, "dir/file.txt", []byte("file"))
+	require.NoError(t, fs.Rename("dir", "moved"))
+	assert.Equal(t, "file", string(ReadFile(t, fs, "moved/file.txt")))
+	_, err = fs.Stat("dir")
+	assert.True(t, os.IsNotExist(err))
+
+	err = fs.Rename("missing.txt", "other.txt")
+	assert.True(t, os.IsNotExist(err))
+}
+
+// checkModeError checks the error of an operation which the mode of a file
+// does not allow.
+func checkModeError(t *testing.T, c Config, expected, err error) {
+	if c.RandomAccess {
+		assert.Error(t, err)
+	} else {
+		assert.Equal(t, expected, err)
+	}
+}
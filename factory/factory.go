@@ -10,12 +10,23 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os/user"
+	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/archive"
+	"github.com/rkcloudchain/extfs/azure"
+	"github.com/rkcloudchain/extfs/ftp"
 	"github.com/rkcloudchain/extfs/hdfs"
+	"github.com/rkcloudchain/extfs/httpfs"
 	"github.com/rkcloudchain/extfs/local"
+	"github.com/rkcloudchain/extfs/s3"
+	"github.com/rkcloudchain/extfs/sftp"
+	"github.com/rkcloudchain/extfs/trash"
+	"github.com/rkcloudchain/extfs/webdav"
+	"github.com/rkcloudchain/extfs/webhdfs"
 )
 
 const (
@@ -55,7 +66,12 @@ func NewFilesystem(u string, cfg *extfs.Config) (extfs.Filesystem, error) {
 		}
 	}
 
-	return createFileSystem(url, cfg)
+	fs, err := createFileSystem(url, cfg)
+	if err != nil || cfg == nil || !cfg.Trash {
+		return fs, err
+	}
+
+	return newTrash(fs, cfg)
 }
 
 func createFileSystem(url *url.URL, cfg *extfs.Config) (extfs.Filesystem, error) {
@@ -73,6 +89,8 @@ func createFileSystem(url *url.URL, cfg *extfs.Config) (extfs.Filesystem, error)
 			cfg = &extfs.Config{}
 		}
 
+		// The authority is either a namenode address or a nameservice ID,
+		// which hdfs.New resolves from the hadoop configuration.
 		authority := url.Host
 		if authority != "" {
 			cfg.Addresses = append(cfg.Addresses, authority)
@@ -84,11 +102,201 @@ func createFileSystem(url *url.URL, cfg *extfs.Config) (extfs.Filesystem, error)
 
 		return hdfs.New(base, cfg)
 
+	case "webhdfs", "swebhdfs":
+		base, err := getBaseDir(url)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := "http://" + url.Host
+		if lower == "swebhdfs" {
+			endpoint = "https://" + url.Host
+		}
+		return webhdfs.New(endpoint, base, cfg)
+
+	case "s3":
+		// The authority is the bucket, the path the prefix of the keys.
+		base, err := getBaseDir(url)
+		if err != nil {
+			return nil, err
+		}
+
+		return s3.New(url.Host, base, cfg)
+
+	case "sftp":
+		base, err := getBaseDir(url)
+		if err != nil {
+			return nil, err
+		}
+
+		return sftp.New(url.Host, base, withUserinfo(url, cfg))
+
+	case "ftp", "ftps":
+		base, err := getBaseDir(url)
+		if err != nil {
+			return nil, err
+		}
+
+		return ftp.New(lower+"://"+url.Host, base, withUserinfo(url, cfg))
+
+	case "webdav", "webdavs":
+		base, err := getBaseDir(url)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := "http://" + url.Host
+		if lower == "webdavs" {
+			endpoint = "https://" + url.Host
+		}
+		return webdav.New(endpoint, base, withUserinfo(url, cfg))
+
+	case "http", "https":
+		base, err := getBaseDir(url)
+		if err != nil {
+			return nil, err
+		}
+
+		return httpfs.New(lower+"://"+url.Host, base, withUserinfo(url, cfg))
+
+	case "abfs", "abfss", "wasb", "wasbs":
+		// The user is the container, the host the account endpoint, such
+		// as container@account.dfs.core.windows.net.
+		base, err := getBaseDir(url)
+		if err != nil {
+			return nil, err
+		}
+
+		container := ""
+		if url.User != nil {
+			container = url.User.Username()
+		}
+		endpoint := "http://" + url.Host
+		if lower == "abfss" || lower == "wasbs" {
+			endpoint = "https://" + url.Host
+		}
+		if cfg != nil && cfg.AzureEndpoint != "" {
+			endpoint = cfg.AzureEndpoint
+		}
+
+		if strings.HasPrefix(lower, "abfs") {
+			return azure.NewDataLake(endpoint, container, base, cfg)
+		}
+		return azure.NewBlob(endpoint, container, base, cfg)
+
+	case "zip", "tar":
+		return newArchive(lower, url, cfg)
+
 	default:
 		return nil, fmt.Errorf("Unsupported filesystem %s", lower)
 	}
 }
 
+// newArchive returns the filesystem over an archive. The URL is either
+// zip:<url of the archive> or zip:///path/of/the/archive for a local
+// archive, optionally followed by !/base, the base directory in the
+// archive. The tar archives may be compressed with gzip.
+func newArchive(format string, archiveURL *url.URL, cfg *extfs.Config) (extfs.Filesystem, error) {
+	location := archiveURL.Opaque
+	if location == "" {
+		location = "file://" + archiveURL.Path
+	}
+	base := "/"
+	if i := strings.LastIndex(location, "!"); i >= 0 {
+		location, base = location[:i], location[i+1:]
+	}
+	if !filepath.IsAbs(base) {
+		return nil, extfs.ErrNeedAbsolutePath
+	}
+
+	// The filesystem of the archive has the directory of the archive as
+	// its base.
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	name := path.Base(u.Path)
+	u.Path = path.Dir(u.Path)
+	u.RawPath = ""
+
+	store, err := createFileSystem(u, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var fs extfs.Filesystem
+	if format == "zip" {
+		fs, err = archive.NewZip(store, name, base)
+	} else {
+		fs, err = archive.NewTar(store, name, base)
+	}
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	return &archiveFS{Filesystem: fs, store: store}, nil
+}
+
+// archiveFS closes the filesystem the archive is stored on with the
+// archive.
+type archiveFS struct {
+	extfs.Filesystem
+	store extfs.Filesystem
+}
+
+func (fs *archiveFS) Close() error {
+	err := fs.Filesystem.Close()
+	if e := fs.store.Close(); err == nil {
+		err = e
+	}
+
+	return err
+}
+
+func newTrash(fs extfs.Filesystem, cfg *extfs.Config) (extfs.Filesystem, error) {
+	dir := cfg.TrashDir
+	if dir == "" {
+		name := cfg.User
+		if name == "" {
+			u, err := user.Current()
+			if err != nil {
+				fs.Close()
+				return nil, err
+			}
+			name = u.Username
+		}
+		dir = trash.DefaultDir(name)
+	}
+
+	t, err := trash.New(fs, dir)
+	if err != nil {
+		fs.Close()
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// withUserinfo returns the configuration with the user and the password of
+// the URL, which override the ones of cfg.
+func withUserinfo(url *url.URL, cfg *extfs.Config) *extfs.Config {
+	if url.User == nil {
+		return cfg
+	}
+
+	c := extfs.Config{}
+	if cfg != nil {
+		c = *cfg
+	}
+	c.User = url.User.Username()
+	if password, ok := url.User.Password(); ok {
+		c.Password = password
+	}
+
+	return &c
+}
+
 func getBaseDir(url *url.URL) (string, error) {
 	base := url.Path
 	if base == "" {
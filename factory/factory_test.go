@@ -7,20 +7,49 @@ SPDX-License-Identifier: Apache-2.0
 package factory
 
 import (
+	"archive/zip"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/azure/azuretest"
+	"github.com/rkcloudchain/extfs/ftp/ftptest"
+	"github.com/rkcloudchain/extfs/hdfs/hdfstest"
+	"github.com/rkcloudchain/extfs/s3/s3test"
+	"github.com/rkcloudchain/extfs/sftp/sftptest"
+	"github.com/rkcloudchain/extfs/trash"
+	"github.com/rkcloudchain/extfs/webdav/webdavtest"
+	"github.com/rkcloudchain/extfs/webhdfs/webhdfstest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-const (
-	hadoopNamenode = "localhost:9000"
-)
+// hadoopNamenode is the namenode of the tests. An in-process cluster is
+// started unless EXTFS_HDFS_NAMENODE names the namenode of a real one.
+var hadoopNamenode = os.Getenv("EXTFS_HDFS_NAMENODE")
+
+func TestMain(m *testing.M) {
+	if hadoopNamenode != "" {
+		os.Exit(m.Run())
+	}
+
+	cluster, err := hdfstest.NewCluster()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	hadoopNamenode = cluster.Addr()
+
+	code := m.Run()
+	cluster.Close()
+	os.Exit(code)
+}
 
 func TestCreateLocalFilesystem(t *testing.T) {
 	tp := filepath.Join(os.TempDir(), "extfs-factory-test")
@@ -64,3 +93,235 @@ func TestCreateHadoopFilesystem(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotZero(t, n)
 }
+
+func TestCreateWebHDFSFilesystem(t *testing.T) {
+	server, err := webhdfstest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	u, err := url.Parse(server.URL())
+	require.NoError(t, err)
+
+	fs, err := New(fmt.Sprintf("webhdfs://%s/opt/hadoop", u.Host), extfs.WithUser("alice"))
+	require.NoError(t, err)
+	defer fs.Close()
+
+	f, err := fs.Create("hello.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	fi, err := fs.Stat("hello.txt")
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), fi.Size())
+
+	sfs, err := New(fmt.Sprintf("swebhdfs://%s/opt/hadoop", u.Host), extfs.WithUser("alice"))
+	require.NoError(t, err)
+	defer sfs.Close()
+}
+
+func TestCreateS3Filesystem(t *testing.T) {
+	server, err := s3test.NewServer("AKIDEXAMPLE", "secret")
+	require.NoError(t, err)
+	defer server.Close()
+	server.CreateBucket("extfs")
+
+	fs, err := New("s3://extfs/opt/data",
+		extfs.WithS3Endpoint(server.URL()),
+		extfs.WithS3PathStyle(true),
+		extfs.WithS3Credentials("AKIDEXAMPLE", "secret", ""))
+	require.NoError(t, err)
+	defer fs.Close()
+
+	f, err := fs.Create("hello.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	assert.Equal(t, []string{"opt/data/hello.txt"}, server.Keys("extfs"))
+}
+
+func TestCreateAzureFilesystem(t *testing.T) {
+	const key = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+	for _, scheme := range []string{"abfs", "wasb"} {
+		server, err := azuretest.NewServer("devstoreaccount1", key, scheme == "abfs")
+		require.NoError(t, err)
+		defer server.Close()
+		server.CreateContainer("extfs")
+
+		fs, err := New(scheme+"://extfs@devstoreaccount1.dfs.core.windows.net/opt/data",
+			extfs.WithAzureEndpoint(server.URL()),
+			extfs.WithAzureAccountKey(key))
+		require.NoError(t, err)
+		defer fs.Close()
+
+		f, err := fs.Create("hello.txt")
+		require.NoError(t, err)
+		_, err = f.Write([]byte("hello world"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		assert.Contains(t, server.Paths("extfs"), "opt/data/hello.txt")
+	}
+
+	_, err := New("abfss://devstoreaccount1.dfs.core.windows.net/data")
+	assert.Error(t, err)
+}
+
+func TestCreateSFTPFilesystem(t *testing.T) {
+	server, err := sftptest.NewServer("alice", "secret")
+	require.NoError(t, err)
+	defer server.Close()
+
+	fs, err := New(fmt.Sprintf("sftp://alice:secret@%s%s/data", server.Addr(), server.Dir()),
+		extfs.WithSSHInsecureIgnoreHostKey(true))
+	require.NoError(t, err)
+	defer fs.Close()
+
+	f, err := fs.Create("hello.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	data, err := ioutil.ReadFile(filepath.Join(server.Dir(), "data", "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+}
+
+func TestCreateFTPFilesystem(t *testing.T) {
+	server, err := ftptest.NewServer("alice", "secret")
+	require.NoError(t, err)
+	defer server.Close()
+
+	fs, err := New(fmt.Sprintf("ftp://alice:secret@%s/data", server.Addr()))
+	require.NoError(t, err)
+	defer fs.Close()
+
+	f, err := fs.Create("hello.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	data, err := ioutil.ReadFile(filepath.Join(server.Dir(), "data", "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	_, err = New(fmt.Sprintf("ftp://alice:wrong@%s/data", server.Addr()))
+	assert.Error(t, err)
+}
+
+func TestCreateWebDAVFilesystem(t *testing.T) {
+	server, err := webdavtest.NewServer("alice", "secret")
+	require.NoError(t, err)
+	defer server.Close()
+
+	u, err := url.Parse(server.URL())
+	require.NoError(t, err)
+	fs, err := New(fmt.Sprintf("webdav://alice:secret@%s/data", u.Host))
+	require.NoError(t, err)
+	defer fs.Close()
+
+	f, err := fs.Create("hello.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	data, err := ioutil.ReadFile(filepath.Join(server.Dir(), "data", "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+}
+
+func TestCreateHTTPFilesystem(t *testing.T) {
+	dir, err := ioutil.TempDir("", "extfs-factory-http")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hello world"), 0644))
+
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer server.Close()
+
+	fs, err := New(server.URL+"/", extfs.WithHTTPAutoindex(true))
+	require.NoError(t, err)
+	defer fs.Close()
+
+	f, err := fs.Open("hello.txt")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Equal(t, "hello world", string(data))
+
+	fis, err := fs.ReadDir("/")
+	require.NoError(t, err)
+	require.Len(t, fis, 1)
+	assert.Equal(t, "hello.txt", fis[0].Name())
+
+	_, err = fs.Create("new.txt")
+	assert.Equal(t, extfs.ErrReadOnly, err)
+}
+
+func TestCreateArchiveFilesystem(t *testing.T) {
+	dir, err := ioutil.TempDir("", "extfs-factory-archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	f, err := os.Create(filepath.Join(dir, "test.zip"))
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	zf, err := w.Create("data/hello.txt")
+	require.NoError(t, err)
+	_, err = zf.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	for _, u := range []string{
+		"zip://" + filepath.Join(dir, "test.zip") + "!/data",
+		"zip:file://" + filepath.Join(dir, "test.zip") + "!/data",
+	} {
+		fs, err := NewFilesystem(u, nil)
+		require.NoError(t, err, u)
+
+		f, err := fs.Open("hello.txt")
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		assert.Equal(t, "hello world", string(data))
+
+		_, err = fs.Create("new.txt")
+		assert.Equal(t, extfs.ErrReadOnly, err)
+		require.NoError(t, fs.Close())
+	}
+
+	_, err = NewFilesystem("zip://"+filepath.Join(dir, "test.zip")+"!data", nil)
+	assert.Equal(t, extfs.ErrNeedAbsolutePath, err)
+	_, err = NewFilesystem("tar://"+filepath.Join(dir, "missing.tar"), nil)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCreateTrashFilesystem(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-factory-test")
+	fs, err := New(fmt.Sprintf("file://%s", tp), extfs.WithUser("alice"), extfs.WithTrash(true))
+	require.NoError(t, err)
+	defer fs.Close()
+
+	f, err := fs.Create("trash.txt")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	err = fs.Remove("trash.txt")
+	require.NoError(t, err)
+
+	_, err = fs.Stat(".Trash/alice/Current/trash.txt")
+	assert.NoError(t, err)
+
+	err = fs.(*trash.Trash).Unwrap().RemoveAll(".Trash")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,387 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ftp
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errPassiveReply = errors.New("FTP passive reply is malformed")
+
+// dialOptions are the parameters of the control connections.
+type dialOptions struct {
+	addr     string
+	user     string
+	password string
+
+	// tlsConfig is nil for plain FTP. The TLS session is negotiated with
+	// AUTH TLS, unless implicit is set.
+	tlsConfig *tls.Config
+	implicit  bool
+
+	dialTimeout time.Duration
+	rpcTimeout  time.Duration
+}
+
+// conn is a logged in control connection. A connection runs one command at
+// a time, and one data transfer at a time.
+type conn struct {
+	netConn  net.Conn
+	text     *textproto.Conn
+	opts     *dialOptions
+	features map[string]string
+
+	// epsv is cleared when the server rejects EPSV, PASV is used instead.
+	epsv bool
+}
+
+// dial opens a control connection, protects it and logs in.
+func dial(opts *dialOptions) (*conn, error) {
+	dialer := &net.Dialer{Timeout: opts.dialTimeout}
+	netConn, err := dialer.Dial("tcp", opts.addr)
+	if err != nil {
+		return nil, err
+	}
+	if opts.tlsConfig != nil && opts.implicit {
+		netConn = tls.Client(netConn, opts.tlsConfig)
+	}
+
+	c := &conn{
+		netConn: netConn,
+		text:    textproto.NewConn(netConn),
+		opts:    opts,
+		epsv:    true,
+	}
+	if err := c.login(); err != nil {
+		c.close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *conn) login() error {
+	if _, _, err := c.response(220); err != nil {
+		return err
+	}
+
+	if c.opts.tlsConfig != nil && !c.opts.implicit {
+		if _, _, err := c.cmd(234, "AUTH TLS"); err != nil {
+			return err
+		}
+		c.netConn = tls.Client(c.netConn, c.opts.tlsConfig)
+		c.text = textproto.NewConn(c.netConn)
+	}
+
+	code, _, err := c.cmd(0, "USER %s", c.opts.user)
+	if err != nil {
+		return err
+	}
+	switch code {
+	case 230:
+	case 331:
+		if _, _, err := c.cmd(230, "PASS %s", c.opts.password); err != nil {
+			return err
+		}
+	default:
+		return &textproto.Error{Code: code, Msg: "unexpected reply to USER"}
+	}
+
+	// The data connections are protected as well.
+	if c.opts.tlsConfig != nil {
+		if _, _, err := c.cmd(200, "PBSZ 0"); err != nil {
+			return err
+		}
+		if _, _, err := c.cmd(200, "PROT P"); err != nil {
+			return err
+		}
+	}
+
+	if err := c.feat(); err != nil {
+		return err
+	}
+	if _, ok := c.features["UTF8"]; ok {
+		c.cmd(200, "OPTS UTF8 ON")
+	}
+	_, _, err = c.cmd(200, "TYPE I")
+	return err
+}
+
+// feat reads the extensions of the server, such as MLST or MFMT.
+func (c *conn) feat() error {
+	c.features = make(map[string]string)
+
+	code, msg, err := c.cmd(0, "FEAT")
+	if err != nil {
+		return err
+	}
+	if code != 211 {
+		// The server has no extension.
+		return nil
+	}
+
+	lines := strings.Split(msg, "\n")
+	for _, line := range lines[1 : len(lines)-1] {
+		fields := strings.SplitN(strings.TrimSpace(line), " ", 2)
+		name := strings.ToUpper(fields[0])
+		if len(fields) == 2 {
+			c.features[name] = fields[1]
+		} else {
+			c.features[name] = ""
+		}
+	}
+
+	return nil
+}
+
+func (c *conn) hasFeature(name string) bool {
+	_, ok := c.features[name]
+	return ok
+}
+
+// cmd sends a command and reads its reply. The reply code must start with
+// expectCode, unless it is zero. A command containing a line break is
+// rejected, since it would be read as several commands.
+func (c *conn) cmd(expectCode int, format string, args ...interface{}) (int, string, error) {
+	if c.opts.rpcTimeout > 0 {
+		c.netConn.SetDeadline(time.Now().Add(c.opts.rpcTimeout))
+		defer c.netConn.SetDeadline(time.Time{})
+	}
+
+	line := fmt.Sprintf(format, args...)
+	if strings.ContainsAny(line, "\r\n") {
+		return 0, "", os.ErrInvalid
+	}
+	if err := c.text.PrintfLine("%s", line); err != nil {
+		return 0, "", err
+	}
+
+	return c.text.ReadResponse(expectCode)
+}
+
+// response reads the reply of a command which was already sent.
+func (c *conn) response(expectCode int) (int, string, error) {
+	if c.opts.rpcTimeout > 0 {
+		c.netConn.SetDeadline(time.Now().Add(c.opts.rpcTimeout))
+		defer c.netConn.SetDeadline(time.Time{})
+	}
+
+	return c.text.ReadResponse(expectCode)
+}
+
+// passive opens a data connection with EPSV, or PASV on the servers which
+// do not know it. The address of the PASV reply is ignored in favor of the
+// one of the control connection, which is the only one reachable behind a
+// NAT.
+func (c *conn) passive() (net.Conn, error) {
+	host, _, err := net.SplitHostPort(c.opts.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	port := 0
+	if c.epsv {
+		_, msg, err := c.cmd(229, "EPSV")
+		if err == nil {
+			port, err = parseEPSV(msg)
+			if err != nil {
+				return nil, err
+			}
+		} else if isProtocolError(err) {
+			c.epsv = false
+		} else {
+			return nil, err
+		}
+	}
+	if port == 0 {
+		_, msg, err := c.cmd(227, "PASV")
+		if err != nil {
+			return nil, err
+		}
+		port, err = parsePASV(msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: c.opts.dialTimeout}
+	dataConn, err := dialer.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+	if c.opts.tlsConfig != nil {
+		dataConn = tls.Client(dataConn, c.opts.tlsConfig)
+	}
+
+	return dataConn, nil
+}
+
+// transfer starts a data transfer from offset. The reply of the end of the
+// transfer is read by finish, once the data connection is closed.
+func (c *conn) transfer(offset int64, format string, args ...interface{}) (net.Conn, error) {
+	dataConn, err := c.passive()
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > 0 {
+		if _, _, err := c.cmd(350, "REST %d", offset); err != nil {
+			dataConn.Close()
+			return nil, err
+		}
+	}
+	if _, _, err := c.cmd(1, format, args...); err != nil {
+		dataConn.Close()
+		return nil, err
+	}
+
+	// The handshake is not left to the first read or write, an empty
+	// upload would not have any.
+	if tlsConn, ok := dataConn.(*tls.Conn); ok {
+		if err := tlsConn.Handshake(); err != nil {
+			dataConn.Close()
+			c.response(0)
+			return nil, err
+		}
+	}
+
+	return dataConn, nil
+}
+
+// finish closes the data connection and reads the reply of the end of the
+// transfer.
+func (c *conn) finish(dataConn net.Conn) error {
+	err := dataConn.Close()
+	if _, _, rerr := c.response(2); rerr != nil {
+		return rerr
+	}
+
+	return err
+}
+
+// list reads the entries of a directory with MLSD.
+func (c *conn) list(fullpath string) ([]*entry, error) {
+	dataConn, err := c.transfer(0, "MLSD %s", fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*entry
+	r := textproto.NewReader(bufio.NewReader(dataConn))
+	for {
+		line, rerr := r.ReadLine()
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			dataConn.Close()
+			return nil, rerr
+		}
+
+		e, perr := parseEntry(line)
+		if perr != nil {
+			continue
+		}
+		if t := e.facts["type"]; t == "cdir" || t == "pdir" {
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	if err := c.finish(dataConn); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// stat reads the entry of a file with MLST, through the control
+// connection.
+func (c *conn) stat(fullpath string) (*entry, error) {
+	_, msg, err := c.cmd(250, "MLST %s", fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The entry is the second line of the reply, after a space.
+	lines := strings.Split(msg, "\n")
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("FTP MLST reply is malformed: %q", msg)
+	}
+
+	return parseEntry(strings.TrimPrefix(lines[1], " "))
+}
+
+// chmod changes the mode of a file with SITE CHMOD.
+func (c *conn) chmod(fullpath string, mode os.FileMode) error {
+	_, _, err := c.cmd(200, "SITE CHMOD %04o %s", mode&os.ModePerm, fullpath)
+	return err
+}
+
+func (c *conn) close() error {
+	return c.netConn.Close()
+}
+
+// quit logs out and closes the connection.
+func (c *conn) quit() error {
+	c.cmd(2, "QUIT")
+	return c.close()
+}
+
+// parseEPSV reads the port of a reply like "Entering Extended Passive Mode
+// (|||6446|)".
+func parseEPSV(msg string) (int, error) {
+	start := strings.Index(msg, "(")
+	end := strings.LastIndex(msg, ")")
+	if start < 0 || end < start+2 {
+		return 0, errPassiveReply
+	}
+
+	// The delimiter is the first character, usually '|'.
+	fields := strings.Split(msg[start+1:end], msg[start+1:start+2])
+	if len(fields) != 5 {
+		return 0, errPassiveReply
+	}
+	port, err := strconv.Atoi(fields[3])
+	if err != nil {
+		return 0, errPassiveReply
+	}
+
+	return port, nil
+}
+
+// parsePASV reads the port of a reply like "Entering Passive Mode
+// (192,168,1,2,25,46)".
+func parsePASV(msg string) (int, error) {
+	start := strings.Index(msg, "(")
+	end := strings.LastIndex(msg, ")")
+	if start < 0 || end < start {
+		return 0, errPassiveReply
+	}
+
+	fields := strings.Split(msg[start+1:end], ",")
+	if len(fields) != 6 {
+		return 0, errPassiveReply
+	}
+	high, err1 := strconv.Atoi(fields[4])
+	low, err2 := strconv.Atoi(fields[5])
+	if err1 != nil || err2 != nil {
+		return 0, errPassiveReply
+	}
+
+	return high<<8 | low, nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ftp
+
+import (
+	"errors"
+	"net/textproto"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+func isProtocolError(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr)
+}
+
+// interpretError maps the reply codes of FTP to the errors of the os
+// package. The servers answer 550 to most of the failed file actions, the
+// message tells them apart.
+func interpretError(err error) error {
+	var protoErr *textproto.Error
+	if !errors.As(err, &protoErr) {
+		return err
+	}
+
+	switch protoErr.Code {
+	case 500, 502, 504:
+		return extfs.ErrUnsupported
+	case 530, 532:
+		return os.ErrPermission
+	case 550:
+		msg := strings.ToLower(protoErr.Msg)
+		switch {
+		case strings.Contains(msg, "no such") || strings.Contains(msg, "not exist"):
+			return os.ErrNotExist
+		case strings.Contains(msg, "permission") || strings.Contains(msg, "denied"):
+			return os.ErrPermission
+		case strings.Contains(msg, "not empty"):
+			return syscall.ENOTEMPTY
+		case strings.Contains(msg, "exists"):
+			return os.ErrExist
+		default:
+			return os.ErrNotExist
+		}
+	default:
+		return err
+	}
+}
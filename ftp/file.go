@@ -0,0 +1,192 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ftp
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+var errClosed = errors.New("FTP file already closed")
+
+// file is a file opened either for reading or for writing. A reader starts
+// a download from its offset on the first read, a writer streams the data
+// to the server until it is closed. Both hold a connection of the pool
+// during their transfer.
+type file struct {
+	fs       *ftpfs
+	name     string
+	fullpath string
+	closed   bool
+
+	// Readers
+	reader *util.RangeReader
+
+	// Writers
+	conn     *conn
+	dataConn net.Conn
+	perm     os.FileMode
+}
+
+func newReader(fs *ftpfs, name, fullpath string, size int64) *file {
+	open := func(off, length int64) (io.ReadCloser, error) {
+		c, dataConn, err := fs.transfer(off, "RETR %s", fullpath)
+		if err != nil {
+			return nil, &os.PathError{Op: "read", Path: fullpath, Err: interpretError(err)}
+		}
+		return &download{fs: fs, fullpath: fullpath, conn: c, dataConn: dataConn, remaining: size - off}, nil
+	}
+
+	return &file{fs: fs, name: name, fullpath: fullpath, reader: &util.RangeReader{Path: fullpath, Size: size, Open: open}}
+}
+
+func newWriter(fs *ftpfs, name, fullpath string, c *conn, dataConn net.Conn, perm os.FileMode) *file {
+	return &file{fs: fs, name: name, fullpath: fullpath, conn: c, dataConn: dataConn, perm: perm}
+}
+
+func (f *file) Close() error {
+	if f.closed {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: errClosed}
+	}
+	f.closed = true
+
+	if f.reader != nil {
+		return f.reader.Close()
+	}
+
+	err := f.conn.finish(f.dataConn)
+	if err == nil && f.perm != 0 {
+		if cerr := f.conn.chmod(f.fullpath, f.perm); interpretError(cerr) != extfs.ErrUnsupported {
+			err = cerr
+		}
+	}
+	f.fs.release(f.conn, err)
+	if err != nil {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Read(p)
+}
+
+func (f *file) ReadAt(p []byte, off int64) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.ReadAt(p, off)
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrUnsupported
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "seek", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	if f.reader != nil {
+		return 0, extfs.ErrReadOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "write", Path: f.fullpath, Err: errClosed}
+	}
+
+	n, err := f.dataConn.Write(p)
+	if err != nil {
+		return n, &os.PathError{Op: "write", Path: f.fullpath, Err: err}
+	}
+
+	return n, nil
+}
+
+func (f *file) WriteAt(p []byte, off int64) (int, error) {
+	return 0, extfs.ErrUnsupported
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+func (f *file) Stat() (os.FileInfo, error) {
+	return f.fs.stat(f.fullpath)
+}
+
+func (f *file) Sync() error {
+	if f.reader == nil {
+		return extfs.ErrUnsupported
+	}
+
+	return nil
+}
+
+func (f *file) Truncate(size int64) error {
+	return extfs.ErrUnsupported
+}
+
+// download is the content of a file downloaded on a connection of the pool.
+// The connection is released once the whole file is read. It is closed if
+// the download is abandoned before, since the server replies to an aborted
+// transfer at its own pace.
+type download struct {
+	fs        *ftpfs
+	fullpath  string
+	conn      *conn
+	dataConn  net.Conn
+	remaining int64
+}
+
+func (d *download) Read(p []byte) (int, error) {
+	if d.dataConn == nil {
+		return 0, io.EOF
+	}
+
+	n, err := d.dataConn.Read(p)
+	d.remaining -= int64(n)
+	if err == io.EOF || (err == nil && d.remaining <= 0) {
+		ferr := d.conn.finish(d.dataConn)
+		d.fs.release(d.conn, ferr)
+		d.conn, d.dataConn = nil, nil
+		if ferr != nil {
+			return n, &os.PathError{Op: "read", Path: d.fullpath, Err: interpretError(ferr)}
+		}
+	}
+
+	return n, err
+}
+
+func (d *download) Close() error {
+	if d.dataConn != nil {
+		d.dataConn.Close()
+		d.conn.close()
+		d.conn, d.dataConn = nil, nil
+	}
+
+	return nil
+}
@@ -0,0 +1,571 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package ftp implements a filesystem over FTP, and over FTPS with explicit
+// or implicit TLS.
+package ftp
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+const (
+	defaultPort         = "21"
+	defaultImplicitPort = "990"
+	anonymousUser       = "anonymous"
+	maxIdleConns        = 4
+)
+
+var errNotDir = errors.New("not a directory")
+
+// ftpfs is a filesystem based on a FTP server. FTP runs one transfer at a
+// time on a connection, so every open file has its own connection, taken
+// from a pool of the idle ones.
+type ftpfs struct {
+	opts *dialOptions
+	base string
+
+	mu     sync.Mutex
+	idle   []*conn
+	closed bool
+}
+
+// New returns a FTP filesystem. The endpoint is the address of the server,
+// such as ftp://host:21 or ftps://host:21. The ftps scheme negotiates TLS
+// with AUTH TLS, or on connection when the configuration asks for implicit
+// TLS, whose port defaults to 990. The client logs in as the user of the
+// configuration, or else anonymously.
+func New(endpoint, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "ftp" && u.Scheme != "ftps" {
+		return nil, fmt.Errorf("Unsupported FTP scheme %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, errors.New("FTP endpoint has no host")
+	}
+
+	opts := &dialOptions{
+		addr:        u.Host,
+		user:        cfg.User,
+		password:    cfg.Password,
+		dialTimeout: cfg.DialTimeout,
+		rpcTimeout:  cfg.RPCTimeout,
+	}
+	if opts.user == "" {
+		opts.user = anonymousUser
+		if opts.password == "" {
+			opts.password = anonymousUser
+		}
+	}
+
+	port := defaultPort
+	if u.Scheme == "ftps" {
+		opts.implicit = cfg.FTPImplicitTLS
+		if opts.implicit {
+			port = defaultImplicitPort
+		}
+
+		opts.tlsConfig = &tls.Config{}
+		if cfg.TLSConfig != nil {
+			opts.tlsConfig = cfg.TLSConfig.Clone()
+		}
+		if opts.tlsConfig.ServerName == "" {
+			opts.tlsConfig.ServerName = u.Hostname()
+		}
+		// Many servers require the data connections to resume the TLS
+		// session of the control connection.
+		if opts.tlsConfig.ClientSessionCache == nil {
+			opts.tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(0)
+		}
+	}
+	if u.Port() == "" {
+		opts.addr = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	// The first connection reports the errors of the address and of the
+	// credentials.
+	c, err := dial(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := &ftpfs{opts: opts, base: baseDir}
+	fs.release(c, nil)
+
+	return fs, nil
+}
+
+func (fs *ftpfs) Create(filename string) (extfs.File, error) {
+	fullpath, err := fs.underlyingPath(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0)
+}
+
+func (fs *ftpfs) Open(filename string) (extfs.File, error) {
+	fullpath, err := fs.underlyingPath(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, os.O_RDONLY, 0)
+}
+
+// OpenFile opens a file either for reading or for writing. An existing file
+// is either truncated or appended to, FTP cannot overwrite part of it.
+func (fs *ftpfs) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	fullpath, err := fs.underlyingPath(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, flag, perm)
+}
+
+func (fs *ftpfs) Remove(filename string) error {
+	fullpath, err := fs.underlyingPath(filename)
+	if err != nil {
+		return err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: underlyingError(err)}
+	}
+
+	return fs.remove(fullpath, fi.IsDir())
+}
+
+func (fs *ftpfs) RemoveAll(path string) error {
+	fullpath, err := fs.underlyingPath(path)
+	if err != nil {
+		return err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: underlyingError(err)}
+	}
+
+	return fs.removeAll(fullpath, fi)
+}
+
+func (fs *ftpfs) Rename(oldpath, newpath string) error {
+	var err error
+	oldpath, err = fs.underlyingPath(oldpath)
+	if err != nil {
+		return err
+	}
+
+	newpath, err = fs.underlyingPath(newpath)
+	if err != nil {
+		return err
+	}
+
+	err = fs.do(func(c *conn) error {
+		if _, _, err := c.cmd(350, "RNFR %s", oldpath); err != nil {
+			return err
+		}
+		_, _, err := c.cmd(250, "RNTO %s", newpath)
+		return err
+	})
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *ftpfs) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := fs.underlyingPath(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.stat(fullpath)
+}
+
+func (fs *ftpfs) ReadDir(path string) ([]os.FileInfo, error) {
+	fullpath, err := fs.underlyingPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := fs.list(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	fis := make([]os.FileInfo, 0, len(entries))
+	for _, e := range entries {
+		fis = append(fis, newFileInfo(e))
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+func (fs *ftpfs) MkdirAll(path string, perm os.FileMode) error {
+	fullpath, err := fs.underlyingPath(path)
+	if err != nil {
+		return err
+	}
+
+	return fs.mkdirAll(fullpath, perm)
+}
+
+// Chmod changes the mode of a file with SITE CHMOD, which most of the unix
+// servers support.
+func (fs *ftpfs) Chmod(name string, mode os.FileMode) error {
+	fullpath, err := fs.underlyingPath(name)
+	if err != nil {
+		return err
+	}
+
+	err = fs.do(func(c *conn) error {
+		return c.chmod(fullpath, mode)
+	})
+	if err != nil {
+		return &os.PathError{Op: "chmod", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+// Chtimes changes the modification time of a file with MFMT. The access
+// time is ignored.
+func (fs *ftpfs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	fullpath, err := fs.underlyingPath(name)
+	if err != nil {
+		return err
+	}
+
+	err = fs.do(func(c *conn) error {
+		if !c.hasFeature("MFMT") {
+			return extfs.ErrUnsupported
+		}
+		_, _, err := c.cmd(213, "MFMT %s %s", mtime.UTC().Format(timeLayout), fullpath)
+		return err
+	})
+	if err != nil {
+		return &os.PathError{Op: "chtimes", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *ftpfs) Close() error {
+	fs.mu.Lock()
+	idle := fs.idle
+	fs.idle = nil
+	fs.closed = true
+	fs.mu.Unlock()
+
+	for _, c := range idle {
+		c.quit()
+	}
+
+	return nil
+}
+
+// underlyingPath returns the path of name on the server. The paths are sent
+// in the commands of the control connection, where a line break would end
+// the command and start another one.
+func (fs *ftpfs) underlyingPath(name string) (string, error) {
+	if strings.ContainsAny(name, "\r\n") {
+		return "", os.ErrInvalid
+	}
+
+	return util.UnderlyingPath(fs.base, name)
+}
+
+func (fs *ftpfs) openFile(name, fullpath string, flag int, perm os.FileMode) (extfs.File, error) {
+	accMode := flag & syscall.O_ACCMODE
+	if accMode == os.O_RDWR {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: extfs.ErrUnsupported}
+	}
+
+	fi, err := fs.stat(fullpath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if accMode == os.O_RDONLY {
+		if !exists {
+			return nil, err
+		}
+		if fi.IsDir() {
+			return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+		}
+		return newReader(fs, name, fullpath, fi.Size()), nil
+	}
+
+	switch {
+	case exists && flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrExist}
+	case !exists && flag&os.O_CREATE == 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrNotExist}
+	case exists && fi.IsDir():
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+	case !exists:
+		if err := fs.mkdirAll(path.Dir(fullpath), 0); err != nil {
+			return nil, err
+		}
+		return fs.upload(name, fullpath, 0, perm)
+	case flag&os.O_TRUNC != 0:
+		return fs.upload(name, fullpath, 0, 0)
+	case flag&os.O_APPEND != 0:
+		// The upload resumes at the end of the file.
+		return fs.upload(name, fullpath, fi.Size(), 0)
+	default:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: extfs.ErrUnsupported}
+	}
+}
+
+// upload starts the upload of a file from offset. The permission is set
+// once the upload is finished, unless it is zero.
+func (fs *ftpfs) upload(name, fullpath string, offset int64, perm os.FileMode) (extfs.File, error) {
+	c, dataConn, err := fs.transfer(offset, "STOR %s", fullpath)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return newWriter(fs, name, fullpath, c, dataConn, perm), nil
+}
+
+func (fs *ftpfs) stat(fullpath string) (os.FileInfo, error) {
+	var e *entry
+	err := fs.do(func(c *conn) error {
+		var err error
+		e, err = c.stat(fullpath)
+		return err
+	})
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return newFileInfo(e), nil
+}
+
+func (fs *ftpfs) list(fullpath string) ([]*entry, error) {
+	var entries []*entry
+	err := fs.do(func(c *conn) error {
+		var err error
+		entries, err = c.list(fullpath)
+		return err
+	})
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return entries, nil
+}
+
+func (fs *ftpfs) remove(fullpath string, dir bool) error {
+	err := fs.do(func(c *conn) error {
+		var err error
+		if dir {
+			_, _, err = c.cmd(250, "RMD %s", fullpath)
+		} else {
+			_, _, err = c.cmd(250, "DELE %s", fullpath)
+		}
+		return err
+	})
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+// removeAll removes a file or a directory tree. The links are removed, not
+// the directories they point to.
+func (fs *ftpfs) removeAll(fullpath string, fi os.FileInfo) error {
+	if fi.IsDir() {
+		entries, err := fs.list(fullpath)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := fs.removeAll(path.Join(fullpath, e.name), newFileInfo(e)); err != nil {
+				return err
+			}
+		}
+	}
+
+	err := fs.remove(fullpath, fi.IsDir())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// mkdirAll creates a directory and its missing parents, and sets the
+// permission of the ones it creates unless perm is zero.
+func (fs *ftpfs) mkdirAll(fullpath string, perm os.FileMode) error {
+	fi, err := fs.stat(fullpath)
+	if err == nil {
+		if !fi.IsDir() {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: errNotDir}
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	if parent := path.Dir(fullpath); parent != fullpath {
+		if err := fs.mkdirAll(parent, perm); err != nil {
+			return err
+		}
+	}
+
+	err = fs.do(func(c *conn) error {
+		if _, _, err := c.cmd(257, "MKD %s", fullpath); err != nil {
+			return err
+		}
+		if perm != 0 {
+			// Not all the servers can change the mode.
+			if err := c.chmod(fullpath, perm); interpretError(err) != extfs.ErrUnsupported {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// Another client may have created it in the meantime.
+		if fi, serr := fs.stat(fullpath); serr == nil && fi.IsDir() {
+			return nil
+		}
+		return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+// conn returns an idle connection of the pool, or else a new one.
+func (fs *ftpfs) conn() (c *conn, pooled bool, err error) {
+	fs.mu.Lock()
+	if n := len(fs.idle); n > 0 {
+		c = fs.idle[n-1]
+		fs.idle = fs.idle[:n-1]
+	}
+	fs.mu.Unlock()
+
+	if c != nil {
+		return c, true, nil
+	}
+
+	c, err = dial(fs.opts)
+	return c, false, err
+}
+
+// release returns a connection to the pool, unless the error of its last
+// command tells that it is broken.
+func (fs *ftpfs) release(c *conn, err error) {
+	if err != nil && !isProtocolError(err) && err != extfs.ErrUnsupported {
+		c.close()
+		return
+	}
+
+	fs.mu.Lock()
+	if !fs.closed && len(fs.idle) < maxIdleConns {
+		fs.idle = append(fs.idle, c)
+		c = nil
+	}
+	fs.mu.Unlock()
+
+	if c != nil {
+		c.quit()
+	}
+}
+
+// acquire runs fn on a connection which the caller releases. An idle
+// connection may have been closed by the server, fn is retried once on a new
+// connection if it fails with a network error.
+func (fs *ftpfs) acquire(fn func(c *conn) error) (*conn, error) {
+	c, pooled, err := fs.conn()
+	if err != nil {
+		return nil, err
+	}
+
+	err = fn(c)
+	if err != nil && pooled && !isProtocolError(err) && err != extfs.ErrUnsupported {
+		c.close()
+		if c, err = dial(fs.opts); err != nil {
+			return nil, err
+		}
+		err = fn(c)
+	}
+
+	return c, err
+}
+
+// do runs fn on a connection of the pool.
+func (fs *ftpfs) do(fn func(c *conn) error) error {
+	c, err := fs.acquire(fn)
+	if c != nil {
+		fs.release(c, err)
+	}
+
+	return err
+}
+
+// transfer starts a data transfer on a connection of the pool, which the
+// caller releases once the transfer is finished.
+func (fs *ftpfs) transfer(offset int64, format string, args ...interface{}) (*conn, net.Conn, error) {
+	var dataConn net.Conn
+	c, err := fs.acquire(func(c *conn) error {
+		var err error
+		dataConn, err = c.transfer(offset, format, args...)
+		return err
+	})
+	if err != nil {
+		if c != nil {
+			fs.release(c, err)
+		}
+		return nil, nil, err
+	}
+
+	return c, dataConn, nil
+}
+
+// underlyingError returns the error wrapped by a *os.PathError.
+func underlyingError(err error) error {
+	if pathErr, ok := err.(*os.PathError); ok {
+		return pathErr.Err
+	}
+
+	return err
+}
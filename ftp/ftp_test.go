@@ -0,0 +1,281 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ftp
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/extfstest"
+	"github.com/rkcloudchain/extfs/ftp/ftptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUser     = "cloudchain"
+	testPassword = "secret"
+)
+
+var server *ftptest.Server
+
+func TestMain(m *testing.M) {
+	extfstest.Main(m, func() (func(), error) {
+		var err error
+		server, err = ftptest.NewServer(testUser, testPassword)
+		if err != nil {
+			return nil, err
+		}
+		return func() { server.Close() }, nil
+	})
+}
+
+func newFilesystem(t *testing.T, base string) extfs.Filesystem {
+	fs, err := New("ftp://"+server.Addr(), base, &extfs.Config{User: testUser, Password: testPassword})
+	require.NoError(t, err)
+
+	return fs
+}
+
+func TestFilesystem(t *testing.T) {
+	extfstest.Test(t, extfstest.Config{New: newFilesystem, Append: true})
+}
+
+func TestCreate(t *testing.T) {
+	fs := newFilesystem(t, "/test1")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "dir/my file.txt", []byte("Hello world"))
+	data, err := ioutil.ReadFile(filepath.Join(server.Dir(), "test1/dir/my file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", string(data))
+
+	fi, err := fs.Stat("dir/my file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "file", fi.Sys().(Facts)["type"])
+}
+
+func TestOpenFile(t *testing.T) {
+	fs := newFilesystem(t, "/test3")
+	defer fs.Close()
+
+	f, err := fs.OpenFile("private.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Hello"))
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("x"), 0)
+	assert.Equal(t, extfs.ErrUnsupported, err)
+	require.NoError(t, f.Close())
+
+	fi, err := fs.Stat("private.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	_, err = fs.OpenFile("private.txt", os.O_RDWR, 0)
+	assert.Equal(t, extfs.ErrUnsupported, err.(*os.PathError).Err)
+
+	_, err = fs.OpenFile("private.txt", os.O_WRONLY, 0)
+	assert.Equal(t, extfs.ErrUnsupported, err.(*os.PathError).Err)
+
+	f, err = fs.Open("private.txt")
+	require.NoError(t, err)
+	assert.Equal(t, extfs.ErrUnsupported, f.Truncate(0))
+	require.NoError(t, f.Close())
+
+	// The upload is resumed at the end of the file.
+	rests := server.Requests("REST")
+	f, err = fs.OpenFile("private.txt", os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte(" world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	assert.Equal(t, rests+1, server.Requests("REST"))
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "private.txt")))
+}
+
+func TestDirectories(t *testing.T) {
+	fs := newFilesystem(t, "/test4")
+	defer fs.Close()
+
+	require.NoError(t, fs.MkdirAll("a/b", 0700))
+	fi, err := fs.Stat("a/b")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), fi.Mode().Perm())
+}
+
+func TestChange(t *testing.T) {
+	fs := newFilesystem(t, "/test6")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+
+	require.NoError(t, fs.Chmod("myfile.txt", 0640))
+	mtime := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, fs.Chtimes("myfile.txt", mtime, mtime))
+
+	fi, err := fs.Stat("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), fi.Mode().Perm())
+	assert.True(t, mtime.Equal(fi.ModTime()))
+
+	err = fs.Chmod("missing.txt", 0640)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCommandInjection(t *testing.T) {
+	fs := newFilesystem(t, "/test8")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "victim.txt", []byte("Hello world"))
+
+	err := fs.Chmod("nothere\r\nDELE /test8/victim.txt", 0644)
+	assert.True(t, errors.Is(err, os.ErrInvalid))
+	err = fs.Rename("victim.txt", "other\nDELE /test8/victim.txt")
+	assert.True(t, errors.Is(err, os.ErrInvalid))
+	_, err = fs.Create("new\r\nRMD /test8")
+	assert.True(t, errors.Is(err, os.ErrInvalid))
+
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "victim.txt")))
+}
+
+func TestUnsupported(t *testing.T) {
+	server, err := ftptest.NewServer("", "")
+	require.NoError(t, err)
+	defer server.Close()
+	server.Disable("EPSV", "MFMT", "SITE")
+
+	// The anonymous login is used without a user, and PASV without EPSV.
+	fs, err := New("ftp://"+server.Addr(), "/", nil)
+	require.NoError(t, err)
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "myfile.txt")))
+	assert.True(t, server.Requests("PASV") > 0)
+
+	err = fs.Chtimes("myfile.txt", time.Now(), time.Now())
+	assert.Equal(t, extfs.ErrUnsupported, err.(*os.PathError).Err)
+	err = fs.Chmod("myfile.txt", 0600)
+	assert.Equal(t, extfs.ErrUnsupported, err.(*os.PathError).Err)
+
+	// The mode of the new directories is left to the server.
+	require.NoError(t, fs.MkdirAll("dir", 0700))
+}
+
+func TestLogin(t *testing.T) {
+	_, err := New("ftp://"+server.Addr(), "/", &extfs.Config{User: testUser, Password: "wrong"})
+	assert.Error(t, err)
+
+	_, err = New("ftp://"+server.Addr(), "/", nil)
+	assert.Error(t, err)
+
+	_, err = New("sftp://"+server.Addr(), "/", nil)
+	assert.Error(t, err)
+}
+
+func TestTLS(t *testing.T) {
+	for _, implicit := range []bool{false, true} {
+		server, err := ftptest.NewTLSServer(testUser, testPassword, implicit)
+		require.NoError(t, err)
+
+		pool := x509.NewCertPool()
+		pool.AddCert(server.Certificate())
+		fs, err := New("ftps://"+server.Addr(), "/data", &extfs.Config{
+			User:           testUser,
+			Password:       testPassword,
+			TLSConfig:      &tls.Config{RootCAs: pool},
+			FTPImplicitTLS: implicit,
+		})
+		require.NoError(t, err)
+
+		extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+		assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "myfile.txt")))
+		fis, err := fs.ReadDir("/")
+		require.NoError(t, err)
+		assert.Len(t, fis, 1)
+
+		assert.Equal(t, !implicit, server.Requests("AUTH") > 0)
+		assert.True(t, server.Requests("PROT") > 0)
+
+		// The certificate is not trusted.
+		_, err = New("ftps://"+server.Addr(), "/", &extfs.Config{
+			User:           testUser,
+			Password:       testPassword,
+			FTPImplicitTLS: implicit,
+		})
+		assert.Error(t, err)
+
+		fs.Close()
+		server.Close()
+	}
+}
+
+func TestConcurrentFiles(t *testing.T) {
+	fs := newFilesystem(t, "/test7")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "a.txt", []byte("aaaa"))
+	extfstest.WriteFile(t, fs, "b.txt", []byte("bbbb"))
+
+	// Every open file has its own connection.
+	fa, err := fs.Open("a.txt")
+	require.NoError(t, err)
+	defer fa.Close()
+	fb, err := fs.Open("b.txt")
+	require.NoError(t, err)
+	defer fb.Close()
+	fc, err := fs.Create("c.txt")
+	require.NoError(t, err)
+
+	buf := make([]byte, 2)
+	for i := 0; i < 2; i++ {
+		_, err = io.ReadFull(fa, buf)
+		require.NoError(t, err)
+		_, err = fc.Write(buf)
+		require.NoError(t, err)
+		_, err = io.ReadFull(fb, buf)
+		require.NoError(t, err)
+		_, err = fc.Write(buf)
+		require.NoError(t, err)
+	}
+	require.NoError(t, fc.Close())
+
+	assert.Equal(t, "aabbaabb", string(extfstest.ReadFile(t, fs, "c.txt")))
+}
+
+func TestParse(t *testing.T) {
+	e, err := parseEntry("Type=dir;Modify=20190601120000.123;UNIX.mode=0750; my dir")
+	require.NoError(t, err)
+	fi := newFileInfo(e)
+	assert.Equal(t, "my dir", fi.Name())
+	assert.True(t, fi.IsDir())
+	assert.Equal(t, os.FileMode(0750), fi.Mode().Perm())
+	assert.Equal(t, time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC), fi.ModTime())
+
+	_, err = parseEntry("type=file;size=1;")
+	assert.Error(t, err)
+
+	port, err := parseEPSV("Entering Extended Passive Mode (|||6446|)")
+	require.NoError(t, err)
+	assert.Equal(t, 6446, port)
+
+	port, err = parsePASV("Entering Passive Mode (192,168,1,2,25,46)")
+	require.NoError(t, err)
+	assert.Equal(t, 25*256+46, port)
+
+	_, err = parsePASV("Entering Passive Mode")
+	assert.Equal(t, errPassiveReply, err)
+}
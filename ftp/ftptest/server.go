@@ -0,0 +1,700 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package ftptest provides an in-process FTP server for the tests. It serves
+// a temporary directory as its root, with the passive transfers, the MLST
+// and MLSD listings, the restarts and the MFMT and SITE CHMOD extensions
+// used by the ftp package. A TLS server protects the control and the data
+// connections, with AUTH TLS or implicitly.
+package ftptest
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const timeLayout = "20060102150405"
+
+var features = []string{"EPSV", "MDTM", "MFMT", "MLST type*;size*;modify*;unix.mode*;", "PASV", "REST STREAM", "SIZE", "UTF8"}
+
+// Server is an in-process FTP server.
+type Server struct {
+	listener  net.Listener
+	user      string
+	password  string
+	dir       string
+	tlsConfig *tls.Config
+	cert      *x509.Certificate
+	implicit  bool
+
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	conns    map[net.Conn]bool
+	requests map[string]int
+	disabled map[string]bool
+}
+
+// NewServer starts a FTP server which accepts the user with its password,
+// or anonymous users if user is empty.
+func NewServer(user, password string) (*Server, error) {
+	return newServer(user, password, false, false)
+}
+
+// NewTLSServer starts a FTPS server with a self-signed certificate. The
+// clients negotiate TLS with AUTH TLS, or on connection if implicit is set.
+func NewTLSServer(user, password string, implicit bool) (*Server, error) {
+	return newServer(user, password, true, implicit)
+}
+
+func newServer(user, password string, secure, implicit bool) (*Server, error) {
+	dir, err := ioutil.TempDir("", "ftptest")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		user:     user,
+		password: password,
+		dir:      dir,
+		implicit: implicit,
+		conns:    make(map[net.Conn]bool),
+		requests: make(map[string]int),
+		disabled: make(map[string]bool),
+	}
+	if secure {
+		if err := s.generateCertificate(); err != nil {
+			os.RemoveAll(dir)
+			return nil, err
+		}
+	}
+
+	s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	if implicit {
+		s.listener = tls.NewListener(s.listener, s.tlsConfig)
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Addr returns the address of the server, such as 127.0.0.1:2121.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Dir returns the directory served as the root of the server.
+func (s *Server) Dir() string {
+	return s.dir
+}
+
+// Certificate returns the certificate of a TLS server.
+func (s *Server) Certificate() *x509.Certificate {
+	return s.cert
+}
+
+// Requests returns the number of commands of a kind, such as REST, the
+// server received.
+func (s *Server) Requests(cmd string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[cmd]
+}
+
+// Disable makes the server reply that the commands are not implemented,
+// and removes them from its features.
+func (s *Server) Disable(cmds ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cmd := range cmds {
+		s.disabled[cmd] = true
+	}
+}
+
+// Close stops the server and removes its directory.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	os.RemoveAll(s.dir)
+	return err
+}
+
+func (s *Server) generateCertificate() error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{"ftptest"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		DNSNames:     []string{"localhost"},
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	s.cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		return err
+	}
+
+	s.tlsConfig = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}
+	return nil
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns[conn] = true
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			sess := &session{server: s, cwd: "/", secure: s.implicit}
+			sess.setConn(conn)
+			sess.serve()
+
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+		}()
+	}
+}
+
+// session is the state of a control connection.
+type session struct {
+	server *Server
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+
+	secure    bool
+	protected bool
+	user      string
+	loggedIn  bool
+	cwd       string
+	rest      int64
+	renameSrc string
+	passive   net.Listener
+}
+
+func (sess *session) setConn(conn net.Conn) {
+	sess.conn = conn
+	sess.reader = bufio.NewReader(conn)
+	sess.writer = bufio.NewWriter(conn)
+}
+
+func (sess *session) reply(code int, format string, args ...interface{}) {
+	fmt.Fprintf(sess.writer, "%d %s\r\n", code, fmt.Sprintf(format, args...))
+	sess.writer.Flush()
+}
+
+// replyLines sends a multi-line reply.
+func (sess *session) replyLines(code int, first string, lines []string, last string) {
+	fmt.Fprintf(sess.writer, "%d-%s\r\n", code, first)
+	for _, line := range lines {
+		fmt.Fprintf(sess.writer, " %s\r\n", line)
+	}
+	fmt.Fprintf(sess.writer, "%d %s\r\n", code, last)
+	sess.writer.Flush()
+}
+
+func (sess *session) replyError(err error) {
+	if pathErr, ok := err.(*os.PathError); ok {
+		err = pathErr.Err
+	}
+	if linkErr, ok := err.(*os.LinkError); ok {
+		err = linkErr.Err
+	}
+	sess.reply(550, "%s", err.Error())
+}
+
+func (sess *session) serve() {
+	defer func() {
+		sess.conn.Close()
+		if sess.passive != nil {
+			sess.passive.Close()
+		}
+	}()
+
+	sess.reply(220, "ftptest ready")
+	for {
+		line, err := sess.reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		fields := strings.SplitN(line, " ", 2)
+		cmd := strings.ToUpper(fields[0])
+		arg := ""
+		if len(fields) == 2 {
+			arg = fields[1]
+		}
+
+		s := sess.server
+		s.mu.Lock()
+		s.requests[cmd]++
+		disabled := s.disabled[cmd]
+		s.mu.Unlock()
+
+		if disabled {
+			sess.reply(502, "Command not implemented")
+			continue
+		}
+		if cmd == "QUIT" {
+			sess.reply(221, "Goodbye")
+			return
+		}
+		sess.handle(cmd, arg)
+	}
+}
+
+func (sess *session) handle(cmd, arg string) {
+	s := sess.server
+
+	switch cmd {
+	case "AUTH":
+		if s.tlsConfig == nil || sess.secure || strings.ToUpper(arg) != "TLS" {
+			sess.reply(504, "AUTH not supported")
+			return
+		}
+		sess.reply(234, "Proceed with negotiation")
+		conn := tls.Server(sess.conn, s.tlsConfig)
+		if err := conn.Handshake(); err != nil {
+			sess.conn.Close()
+			return
+		}
+		sess.setConn(conn)
+		sess.secure = true
+		return
+	case "USER":
+		sess.user = arg
+		sess.loggedIn = false
+		if s.user == "" && arg == "anonymous" {
+			sess.loggedIn = true
+			sess.reply(230, "Logged in")
+			return
+		}
+		sess.reply(331, "Password required")
+		return
+	case "PASS":
+		if s.user == "" || sess.user != s.user || arg != s.password {
+			sess.reply(530, "Login incorrect")
+			return
+		}
+		sess.loggedIn = true
+		sess.reply(230, "Logged in")
+		return
+	case "FEAT":
+		var lines []string
+		s.mu.Lock()
+		for _, feature := range features {
+			if !s.disabled[strings.Fields(feature)[0]] {
+				lines = append(lines, feature)
+			}
+		}
+		s.mu.Unlock()
+		sess.replyLines(211, "Features:", lines, "End")
+		return
+	}
+
+	if !sess.loggedIn {
+		sess.reply(530, "Not logged in")
+		return
+	}
+
+	switch cmd {
+	case "PBSZ":
+		sess.reply(200, "PBSZ=0")
+	case "PROT":
+		switch {
+		case strings.ToUpper(arg) == "C":
+			sess.protected = false
+			sess.reply(200, "Protection set to Clear")
+		case strings.ToUpper(arg) == "P" && sess.secure:
+			sess.protected = true
+			sess.reply(200, "Protection set to Private")
+		default:
+			sess.reply(536, "Protection level not supported")
+		}
+	case "OPTS", "NOOP":
+		sess.reply(200, "OK")
+	case "TYPE":
+		sess.reply(200, "Type set to %s", arg)
+	case "SYST":
+		sess.reply(215, "UNIX Type: L8")
+	case "PWD":
+		sess.reply(257, "%q is the current directory", sess.cwd)
+	case "CWD":
+		sess.changeDir(arg)
+	case "EPSV", "PASV":
+		sess.listen(cmd)
+	case "REST":
+		offset, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || offset < 0 {
+			sess.reply(501, "Invalid offset")
+			return
+		}
+		sess.rest = offset
+		sess.reply(350, "Restarting at %d", offset)
+	case "RETR":
+		sess.retrieve(arg)
+	case "STOR", "APPE":
+		sess.store(arg, cmd == "APPE")
+	case "MLSD":
+		sess.list(arg)
+	case "MLST":
+		sess.stat(arg)
+	case "SIZE":
+		fi, err := os.Stat(sess.local(arg))
+		if err != nil {
+			sess.replyError(err)
+			return
+		}
+		sess.reply(213, "%d", fi.Size())
+	case "MDTM":
+		fi, err := os.Stat(sess.local(arg))
+		if err != nil {
+			sess.replyError(err)
+			return
+		}
+		sess.reply(213, "%s", fi.ModTime().UTC().Format(timeLayout))
+	case "MFMT":
+		sess.setTime(arg)
+	case "SITE":
+		sess.site(arg)
+	case "DELE":
+		fi, err := os.Lstat(sess.local(arg))
+		if err == nil && fi.IsDir() {
+			err = syscall.EISDIR
+		}
+		if err == nil {
+			err = os.Remove(sess.local(arg))
+		}
+		sess.result(250, err, "File removed")
+	case "RMD":
+		fi, err := os.Lstat(sess.local(arg))
+		if err == nil && !fi.IsDir() {
+			err = syscall.ENOTDIR
+		}
+		if err == nil {
+			err = syscall.Rmdir(sess.local(arg))
+		}
+		sess.result(250, err, "Directory removed")
+	case "MKD":
+		err := os.Mkdir(sess.local(arg), 0755)
+		sess.result(257, err, fmt.Sprintf("%q created", sess.virtual(arg)))
+	case "RNFR":
+		if _, err := os.Lstat(sess.local(arg)); err != nil {
+			sess.replyError(err)
+			return
+		}
+		sess.renameSrc = sess.local(arg)
+		sess.reply(350, "Ready for RNTO")
+	case "RNTO":
+		if sess.renameSrc == "" {
+			sess.reply(503, "RNFR required first")
+			return
+		}
+		err := os.Rename(sess.renameSrc, sess.local(arg))
+		sess.renameSrc = ""
+		sess.result(250, err, "Renamed")
+	default:
+		sess.reply(502, "Command not implemented")
+	}
+}
+
+func (sess *session) result(code int, err error, msg string) {
+	if err != nil {
+		sess.replyError(err)
+		return
+	}
+	sess.reply(code, "%s", msg)
+}
+
+// virtual returns the absolute path of an argument on the server.
+func (sess *session) virtual(p string) string {
+	if !path.IsAbs(p) {
+		p = path.Join(sess.cwd, p)
+	}
+	return path.Clean(p)
+}
+
+// local returns the path of an argument on the local filesystem.
+func (sess *session) local(p string) string {
+	return filepath.Join(sess.server.dir, filepath.FromSlash(sess.virtual(p)))
+}
+
+func (sess *session) changeDir(arg string) {
+	fi, err := os.Stat(sess.local(arg))
+	if err == nil && !fi.IsDir() {
+		err = syscall.ENOTDIR
+	}
+	if err != nil {
+		sess.replyError(err)
+		return
+	}
+	sess.cwd = sess.virtual(arg)
+	sess.reply(250, "Directory changed")
+}
+
+func (sess *session) listen(cmd string) {
+	if sess.passive != nil {
+		sess.passive.Close()
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		sess.reply(425, "Cannot open data connection")
+		return
+	}
+	sess.passive = l
+
+	port := l.Addr().(*net.TCPAddr).Port
+	if cmd == "EPSV" {
+		sess.reply(229, "Entering Extended Passive Mode (|||%d|)", port)
+		return
+	}
+	sess.reply(227, "Entering Passive Mode (127,0,0,1,%d,%d)", port>>8, port&0xff)
+}
+
+// dataConn accepts the data connection of a transfer, once the 150 reply is
+// sent.
+func (sess *session) dataConn() (net.Conn, error) {
+	l := sess.passive
+	sess.passive = nil
+	if l == nil {
+		return nil, fmt.Errorf("use PASV or EPSV first")
+	}
+	defer l.Close()
+
+	l.(*net.TCPListener).SetDeadline(time.Now().Add(10 * time.Second))
+	conn, err := l.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if sess.protected {
+		tlsConn := tls.Server(conn, sess.server.tlsConfig)
+		if err := tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	return conn, nil
+}
+
+func (sess *session) transfer(fn func(conn net.Conn) error) {
+	sess.reply(150, "Opening data connection")
+	conn, err := sess.dataConn()
+	if err != nil {
+		sess.reply(425, "Cannot open data connection: %v", err)
+		return
+	}
+
+	err = fn(conn)
+	if cerr := conn.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		sess.reply(426, "Transfer aborted: %v", err)
+		return
+	}
+	sess.reply(226, "Transfer complete")
+}
+
+func (sess *session) retrieve(arg string) {
+	offset := sess.rest
+	sess.rest = 0
+
+	f, err := os.Open(sess.local(arg))
+	if err != nil {
+		sess.replyError(err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		sess.replyError(err)
+		return
+	}
+
+	sess.transfer(func(conn net.Conn) error {
+		_, err := io.Copy(conn, f)
+		return err
+	})
+}
+
+func (sess *session) store(arg string, appending bool) {
+	offset := sess.rest
+	sess.rest = 0
+
+	flag := os.O_WRONLY | os.O_CREATE
+	switch {
+	case appending:
+		flag |= os.O_APPEND
+	case offset == 0:
+		flag |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(sess.local(arg), flag, 0644)
+	if err != nil {
+		sess.replyError(err)
+		return
+	}
+	defer f.Close()
+	if offset > 0 {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			sess.replyError(err)
+			return
+		}
+	}
+
+	sess.transfer(func(conn net.Conn) error {
+		_, err := io.Copy(f, conn)
+		return err
+	})
+}
+
+// facts returns the facts of a file, followed by a space.
+func facts(fi os.FileInfo, kind string) string {
+	if kind == "" {
+		switch {
+		case fi.IsDir():
+			kind = "dir"
+		case fi.Mode()&os.ModeSymlink != 0:
+			kind = "OS.unix=symlink"
+		default:
+			kind = "file"
+		}
+	}
+
+	return fmt.Sprintf("type=%s;size=%d;modify=%s;unix.mode=0%o; ", kind, fi.Size(),
+		fi.ModTime().UTC().Format(timeLayout), fi.Mode().Perm())
+}
+
+func (sess *session) list(arg string) {
+	dir := sess.local(arg)
+	fi, err := os.Stat(dir)
+	if err == nil && !fi.IsDir() {
+		sess.reply(501, "%s is not a directory", sess.virtual(arg))
+		return
+	}
+	if err != nil {
+		sess.replyError(err)
+		return
+	}
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		sess.replyError(err)
+		return
+	}
+
+	sess.transfer(func(conn net.Conn) error {
+		w := bufio.NewWriter(conn)
+		fmt.Fprintf(w, "%s.\r\n", facts(fi, "cdir"))
+		for _, child := range fis {
+			fmt.Fprintf(w, "%s%s\r\n", facts(child, ""), child.Name())
+		}
+		return w.Flush()
+	})
+}
+
+func (sess *session) stat(arg string) {
+	fi, err := os.Lstat(sess.local(arg))
+	if err != nil {
+		sess.replyError(err)
+		return
+	}
+
+	name := sess.virtual(arg)
+	sess.replyLines(250, "Listing "+name, []string{facts(fi, "") + name}, "End")
+}
+
+func (sess *session) setTime(arg string) {
+	fields := strings.SplitN(arg, " ", 2)
+	if len(fields) != 2 {
+		sess.reply(501, "MFMT needs a time and a path")
+		return
+	}
+	mtime, err := time.Parse(timeLayout, fields[0])
+	if err != nil {
+		sess.reply(501, "Invalid time")
+		return
+	}
+
+	if err := os.Chtimes(sess.local(fields[1]), mtime, mtime); err != nil {
+		sess.replyError(err)
+		return
+	}
+	sess.reply(213, "Modify=%s; %s", fields[0], sess.virtual(fields[1]))
+}
+
+func (sess *session) site(arg string) {
+	fields := strings.SplitN(arg, " ", 3)
+	if len(fields) != 3 || strings.ToUpper(fields[0]) != "CHMOD" {
+		sess.reply(504, "SITE command not implemented")
+		return
+	}
+	mode, err := strconv.ParseUint(fields[1], 8, 32)
+	if err != nil {
+		sess.reply(501, "Invalid mode")
+		return
+	}
+
+	err = os.Chmod(sess.local(fields[2]), os.FileMode(mode))
+	sess.result(200, err, "SITE CHMOD command successful")
+}
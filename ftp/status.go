@@ -0,0 +1,119 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ftp
+
+import (
+	"errors"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultFileMode      = 0644
+	defaultDirectoryMode = 0755
+	timeLayout           = "20060102150405"
+)
+
+var errEntry = errors.New("FTP entry is malformed")
+
+// Facts are the facts of a MLST or MLSD entry, such as type, size, modify
+// or unix.mode, with lowercase names. They are the Sys() of the
+// os.FileInfo.
+type Facts map[string]string
+
+// entry is a file of a MLST or MLSD reply.
+type entry struct {
+	name  string
+	facts Facts
+}
+
+// parseEntry reads an entry like "type=file;size=11;modify=20190601120000;
+// name". The name is separated from the facts by a space, and may contain
+// spaces itself.
+func parseEntry(line string) (*entry, error) {
+	i := strings.Index(line, " ")
+	if i < 0 {
+		return nil, errEntry
+	}
+
+	e := &entry{name: line[i+1:], facts: make(Facts)}
+	for _, fact := range strings.Split(line[:i], ";") {
+		if fact == "" {
+			continue
+		}
+		kv := strings.SplitN(fact, "=", 2)
+		if len(kv) != 2 {
+			return nil, errEntry
+		}
+		e.facts[strings.ToLower(kv[0])] = kv[1]
+	}
+
+	return e, nil
+}
+
+type fileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+	facts   Facts
+}
+
+// newFileInfo returns the file info of an entry. The permission is the one
+// of the unix.mode fact, or else a default one as FTP has no standard fact
+// for it.
+func newFileInfo(e *entry) *fileInfo {
+	fi := &fileInfo{name: path.Base(e.name), facts: e.facts}
+	fi.size, _ = strconv.ParseInt(e.facts["size"], 10, 64)
+
+	if t, err := time.Parse(timeLayout, strings.SplitN(e.facts["modify"], ".", 2)[0]); err == nil {
+		fi.modTime = t
+	}
+
+	kind := strings.ToLower(e.facts["type"])
+	switch {
+	case kind == "dir" || kind == "cdir" || kind == "pdir":
+		fi.mode = os.ModeDir | defaultDirectoryMode
+	case strings.HasPrefix(kind, "os.unix=slink") || kind == "os.unix=symlink":
+		fi.mode = os.ModeSymlink | defaultFileMode
+	default:
+		fi.mode = defaultFileMode
+	}
+
+	if mode, err := strconv.ParseUint(e.facts["unix.mode"], 8, 32); err == nil {
+		fi.mode = fi.mode&os.ModeType | os.FileMode(mode)&os.ModePerm
+	}
+
+	return fi
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	return fi.size
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.mode.IsDir()
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return fi.facts
+}
@@ -1,8 +1,26 @@
 module github.com/rkcloudchain/extfs
 
+go 1.17
+
+require (
+	github.com/jcmturner/gokrb5/v8 v8.4.4
+	github.com/pkg/sftp v1.13.6
+	github.com/stretchr/testify v1.8.1
+	golang.org/x/crypto v0.11.0
+	golang.org/x/net v0.12.0
+	google.golang.org/protobuf v1.31.0
+)
+
 require (
-	github.com/colinmarc/hdfs/v2 v2.0.0
-	github.com/stretchr/testify v1.3.0
-	golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4 // indirect
-	gopkg.in/jcmturner/goidentity.v2 v2.0.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/hashicorp/go-uuid v1.0.3 // indirect
+	github.com/jcmturner/aescts/v2 v2.0.0 // indirect
+	github.com/jcmturner/dnsutils/v2 v2.0.0 // indirect
+	github.com/jcmturner/gofork v1.7.6 // indirect
+	github.com/jcmturner/goidentity/v6 v6.0.1 // indirect
+	github.com/jcmturner/rpc/v2 v2.0.3 // indirect
+	github.com/kr/fs v0.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.10.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
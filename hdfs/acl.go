@@ -0,0 +1,107 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// The extfs ACL scopes and types use the same values as the protocol enums.
+type aclEntryProto struct {
+	Type        extfs.ACLType  `json:"type"`
+	Scope       extfs.ACLScope `json:"scope"`
+	Permissions os.FileMode    `json:"permissions"`
+	Name        string         `json:"name,omitempty"`
+}
+
+type aclStatusProto struct {
+	Owner      string          `json:"owner"`
+	Group      string          `json:"group"`
+	Sticky     bool            `json:"sticky"`
+	Entries    []aclEntryProto `json:"entries"`
+	Permission struct {
+		Perm os.FileMode `json:"perm"`
+	} `json:"permission"`
+}
+
+type aclSpecRequest struct {
+	Src     string          `json:"src"`
+	ACLSpec []aclEntryProto `json:"aclSpec,omitempty"`
+}
+
+type getACLStatusResponse struct {
+	Result aclStatusProto `json:"result"`
+}
+
+func (fs *hadoop) GetACL(name string) (*extfs.ACLStatus, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &getACLStatusResponse{}
+	err = fs.namenode.call("getAclStatus", &aclSpecRequest{Src: fullpath}, resp)
+	if err != nil {
+		return nil, &os.PathError{Op: "getacl", Path: fullpath, Err: interpretException(err)}
+	}
+
+	status := &extfs.ACLStatus{
+		Owner:      resp.Result.Owner,
+		Group:      resp.Result.Group,
+		Sticky:     resp.Result.Sticky,
+		Permission: resp.Result.Permission.Perm & os.ModePerm,
+	}
+	for _, e := range resp.Result.Entries {
+		status.Entries = append(status.Entries, extfs.ACLEntry{
+			Scope: e.Scope,
+			Type:  e.Type,
+			Name:  e.Name,
+			Perm:  e.Permissions,
+		})
+	}
+
+	return status, nil
+}
+
+func (fs *hadoop) SetACL(name string, entries []extfs.ACLEntry) error {
+	return fs.changeACL("setAcl", name, entries)
+}
+
+func (fs *hadoop) ModifyACLEntries(name string, entries []extfs.ACLEntry) error {
+	return fs.changeACL("modifyAclEntries", name, entries)
+}
+
+func (fs *hadoop) RemoveACL(name string) error {
+	return fs.changeACL("removeAcl", name, nil)
+}
+
+func (fs *hadoop) changeACL(method, name string, entries []extfs.ACLEntry) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	req := &aclSpecRequest{Src: fullpath}
+	for _, e := range entries {
+		req.ACLSpec = append(req.ACLSpec, aclEntryProto{
+			Type:        e.Type,
+			Scope:       e.Scope,
+			Permissions: e.Perm & 07,
+			Name:        e.Name,
+		})
+	}
+
+	err = fs.namenode.call(method, req, nil)
+	if err != nil {
+		return &os.PathError{Op: method, Path: fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
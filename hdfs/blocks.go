@@ -0,0 +1,66 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+type getBlockLocationsRequest struct {
+	Src    string `json:"src"`
+	Offset int64  `json:"offset"`
+	Length int64  `json:"length"`
+}
+
+type getBlockLocationsResponse struct {
+	Locations struct {
+		Blocks []struct {
+			B struct {
+				NumBytes int64 `json:"numBytes,string"`
+			} `json:"b"`
+			Offset int64 `json:"offset,string"`
+			Locs   []struct {
+				ID struct {
+					HostName string `json:"hostName"`
+				} `json:"id"`
+			} `json:"locs"`
+		} `json:"blocks"`
+	} `json:"locations"`
+}
+
+func (fs *hadoop) BlockLocations(path string, offset, length int64) ([]extfs.BlockLocation, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &getBlockLocationsRequest{Src: fullpath, Offset: offset, Length: length}
+	resp := &getBlockLocationsResponse{}
+	err = fs.namenode.call("getBlockLocations", req, resp)
+	if err != nil {
+		return nil, &os.PathError{Op: "blocklocations", Path: fullpath, Err: interpretException(err)}
+	}
+
+	locations := make([]extfs.BlockLocation, 0, len(resp.Locations.Blocks))
+	for _, b := range resp.Locations.Blocks {
+		hosts := make([]string, 0, len(b.Locs))
+		for _, loc := range b.Locs {
+			hosts = append(hosts, loc.ID.HostName)
+		}
+
+		locations = append(locations, extfs.BlockLocation{
+			Offset: b.Offset,
+			Length: b.B.NumBytes,
+			Hosts:  hosts,
+		})
+	}
+
+	return locations, nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Checksum returns the MD5MD5CRC32C checksum computed by the datanodes. The
+// other algorithms are computed by reading the file.
+func (fs *hadoop) Checksum(path string, algorithm extfs.ChecksumAlgorithm) (*extfs.FileChecksum, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	fr, err := fs.open(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	defer fr.Close()
+
+	if algorithm != extfs.MD5MD5CRC32C {
+		return extfs.ComputeChecksum(fr, algorithm, 0)
+	}
+
+	sum, err := fr.Checksum()
+	if err != nil {
+		return nil, err
+	}
+
+	return &extfs.FileChecksum{Algorithm: algorithm, Sum: sum}, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rkcloudchain/extfs/util"
+	"google.golang.org/protobuf/proto"
+)
+
+type concatRequest struct {
+	Trg  string   `json:"trg"`
+	Srcs []string `json:"srcs"`
+}
+
+type fileStatusProto struct {
+	Blocksize int64 `json:"blocksize,string"`
+}
+
+// Concat moves the blocks of the sources to the target without copying
+// them. All the files must have the same block size and the sources must
+// not be empty.
+func (fs *hadoop) Concat(target string, sources []string) error {
+	trg, err := util.UnderlyingPath(fs.base, target)
+	if err != nil {
+		return err
+	}
+
+	_, blockSize, err := fs.concatStat(trg)
+	if err != nil {
+		return err
+	}
+
+	srcs := make([]string, 0, len(sources))
+	seen := map[string]bool{trg: true}
+	for _, source := range sources {
+		src, err := util.UnderlyingPath(fs.base, source)
+		if err != nil {
+			return err
+		}
+		if seen[src] {
+			return &os.PathError{Op: "concat", Path: src, Err: errors.New("Duplicate concat source")}
+		}
+		seen[src] = true
+
+		size, bs, err := fs.concatStat(src)
+		if err != nil {
+			return err
+		}
+		if bs != blockSize {
+			return &os.PathError{Op: "concat", Path: src, Err: fmt.Errorf("Block size %d differs from %d of the target", bs, blockSize)}
+		}
+		if size == 0 {
+			return &os.PathError{Op: "concat", Path: src, Err: errors.New("Empty concat source")}
+		}
+
+		srcs = append(srcs, src)
+	}
+
+	if len(srcs) == 0 {
+		return nil
+	}
+
+	err = fs.namenode.call("concat", &concatRequest{Trg: trg, Srcs: srcs}, nil)
+	if err != nil {
+		return &os.PathError{Op: "concat", Path: trg, Err: interpretQuotaException(interpretException(err))}
+	}
+
+	return nil
+}
+
+// concatStat returns the size and the block size of a concat file.
+func (fs *hadoop) concatStat(fullpath string) (int64, int64, error) {
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return 0, 0, err
+	}
+	if fi.IsDir() {
+		return 0, 0, &os.PathError{Op: "concat", Path: fullpath, Err: errors.New("Is a directory")}
+	}
+
+	status := &fileStatusProto{}
+	if m, ok := fi.Sys().(proto.Message); ok {
+		if err := decodeMessage(m, status); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return fi.Size(), status.Blocksize, nil
+}
@@ -0,0 +1,44 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"fmt"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs/hadoopconf"
+)
+
+// loadHadoopConf returns the hadoop configuration of cfg. The files of
+// HadoopConfDir are used in place of the environment ones, and the
+// HadoopConf properties take precedence over both.
+func loadHadoopConf(cfg *extfs.Config) (hadoopconf.HadoopConf, error) {
+	var conf hadoopconf.HadoopConf
+	var err error
+
+	switch {
+	case cfg.HadoopConfDir != "":
+		conf, err = hadoopconf.Load(cfg.HadoopConfDir)
+		if err == nil && conf == nil {
+			err = fmt.Errorf("No hadoop configuration found in %s", cfg.HadoopConfDir)
+		}
+	case !cfg.DisableHadoopEnv:
+		conf, err = hadoopconf.LoadFromEnvironment()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.HadoopConf) != 0 && conf == nil {
+		conf = make(hadoopconf.HadoopConf, len(cfg.HadoopConf))
+	}
+	for k, v := range cfg.HadoopConf {
+		conf[k] = v
+	}
+
+	return conf, nil
+}
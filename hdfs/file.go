@@ -9,8 +9,8 @@ package hdfs
 import (
 	"os"
 
-	"github.com/colinmarc/hdfs/v2"
 	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
 )
 
 type file struct {
@@ -26,7 +26,7 @@ func (f *file) Close() error {
 	if f.reader != nil {
 		return f.reader.Close()
 	} else if f.writer != nil {
-		return f.writer.Close()
+		return interpretQuotaException(f.writer.Close())
 	} else {
 		return nil
 	}
@@ -61,7 +61,8 @@ func (f *file) Write(p []byte) (int, error) {
 		return 0, extfs.ErrReadOnly
 	}
 
-	return f.writer.Write(p)
+	n, err := f.writer.Write(p)
+	return n, interpretQuotaException(err)
 }
 
 func (f *file) WriteAt(p []byte, off int64) (int, error) {
@@ -85,7 +86,7 @@ func (f *file) Stat() (os.FileInfo, error) {
 
 func (f *file) Sync() error {
 	if f.writer != nil {
-		return f.writer.Flush()
+		return interpretQuotaException(f.writer.Flush())
 	}
 
 	return nil
@@ -14,9 +14,8 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/colinmarc/hdfs/v2"
-	"github.com/colinmarc/hdfs/v2/hadoopconf"
 	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
 	"github.com/rkcloudchain/extfs/util"
 )
 
@@ -27,21 +26,24 @@ const (
 
 // hadoop s a filesystem based on the hadoop filesystem.
 type hadoop struct {
-	client *hdfs.Client
-	base   string
+	client   *hdfs.Client
+	namenode *namenode
+	retry    *retryPolicy
+	base     string
 }
 
 // New returns a hadoop filesystem.
 func New(baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
-	hadoopCfg, err := hadoopconf.LoadFromEnvironment()
+	hadoopCfg, err := loadHadoopConf(cfg)
 	if err != nil {
 		return nil, err
 	}
 
 	options := hdfs.ClientOptionsFromConf(hadoopCfg)
 	options.UseDatanodeHostname = cfg.UseDatanodeHostname
-	if len(cfg.Addresses) != 0 {
-		options.Addresses = append(options.Addresses, cfg.Addresses...)
+	options.Addresses, err = resolveNamenodes(hadoopCfg, options.Addresses, cfg.Addresses)
+	if err != nil {
+		return nil, err
 	}
 	if cfg.User != "" {
 		options.User = cfg.User
@@ -53,13 +55,23 @@ func New(baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
 			options.User = u.Username
 		}
 	}
-
-	client, err := hdfs.NewClient(options)
+	if err = configureKerberos(cfg, &options); err != nil {
+		return nil, err
+	}
+	if err = configureProtection(cfg, &options); err != nil {
+		return nil, err
+	}
+	retry := newRetryPolicy(cfg, configureDialers(cfg, &options))
+	var client *hdfs.Client
+	err = retry.do(func() (err error) {
+		client, err = hdfs.NewClient(options)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &hadoop{client, baseDir}, nil
+	return &hadoop{client, newNamenode(client, retry), retry, baseDir}, nil
 }
 
 func (fs *hadoop) Create(filename string) (extfs.File, error) {
@@ -104,7 +116,7 @@ func (fs *hadoop) OpenFile(filename string, flag int, perm os.FileMode) (extfs.F
 	}
 
 	if flag&os.O_CREATE != 0 {
-		_, err := fs.client.Stat(fullpath)
+		_, err := fs.stat(fullpath)
 		if err == nil && flag&os.O_EXCL != 0 {
 			return nil, os.ErrExist
 		}
@@ -122,7 +134,9 @@ func (fs *hadoop) Remove(filename string) error {
 		return err
 	}
 
-	return fs.client.Remove(fullpath)
+	return fs.retry.doOnce(func() error {
+		return fs.client.Remove(fullpath)
+	})
 }
 
 func (fs *hadoop) RemoveAll(path string) error {
@@ -131,7 +145,11 @@ func (fs *hadoop) RemoveAll(path string) error {
 		return err
 	}
 
-	return fs.client.Remove(fullpath)
+	// Unlike in v2.0 of the client library, Remove no longer deletes the
+	// children of a directory.
+	return fs.retry.doOnce(func() error {
+		return fs.client.RemoveAll(fullpath)
+	})
 }
 
 func (fs *hadoop) Rename(oldpath, newpath string) error {
@@ -146,7 +164,9 @@ func (fs *hadoop) Rename(oldpath, newpath string) error {
 		return err
 	}
 
-	return fs.client.Rename(oldpath, newpath)
+	return interpretQuotaException(fs.retry.doOnce(func() error {
+		return fs.client.Rename(oldpath, newpath)
+	}))
 }
 
 func (fs *hadoop) Stat(filename string) (os.FileInfo, error) {
@@ -155,7 +175,7 @@ func (fs *hadoop) Stat(filename string) (os.FileInfo, error) {
 		return nil, err
 	}
 
-	return fs.client.Stat(fullpath)
+	return fs.stat(fullpath)
 }
 
 func (fs *hadoop) ReadDir(path string) ([]os.FileInfo, error) {
@@ -164,7 +184,13 @@ func (fs *hadoop) ReadDir(path string) ([]os.FileInfo, error) {
 		return nil, err
 	}
 
-	return fs.client.ReadDir(fullpath)
+	var fis []os.FileInfo
+	err = fs.retry.do(func() (err error) {
+		fis, err = fs.client.ReadDir(fullpath)
+		return err
+	})
+
+	return fis, err
 }
 
 func (fs *hadoop) MkdirAll(path string, perm os.FileMode) error {
@@ -173,7 +199,7 @@ func (fs *hadoop) MkdirAll(path string, perm os.FileMode) error {
 		return err
 	}
 
-	return fs.client.MkdirAll(fullpath, defaultDirectoryMode)
+	return interpretQuotaException(fs.mkdirAll(fullpath))
 }
 
 func (fs *hadoop) Chmod(name string, mode os.FileMode) error {
@@ -182,7 +208,9 @@ func (fs *hadoop) Chmod(name string, mode os.FileMode) error {
 		return err
 	}
 
-	return fs.client.Chmod(fullpath, mode)
+	return fs.retry.doOnce(func() error {
+		return fs.client.Chmod(fullpath, mode)
+	})
 }
 
 func (fs *hadoop) Chtimes(name string, atime time.Time, mtime time.Time) error {
@@ -191,7 +219,9 @@ func (fs *hadoop) Chtimes(name string, atime time.Time, mtime time.Time) error {
 		return err
 	}
 
-	return fs.client.Chtimes(fullpath, atime, mtime)
+	return fs.retry.doOnce(func() error {
+		return fs.client.Chtimes(fullpath, atime, mtime)
+	})
 }
 
 func (fs *hadoop) Close() error {
@@ -200,21 +230,25 @@ func (fs *hadoop) Close() error {
 
 func (fs *hadoop) createFile(fullpath string) (extfs.File, error) {
 	dir := filepath.Dir(fullpath)
-	err := fs.client.MkdirAll(dir, defaultDirectoryMode)
+	err := fs.mkdirAll(dir)
 	if err != nil {
-		return nil, err
+		return nil, interpretQuotaException(err)
 	}
 
-	fw, err := fs.client.Create(fullpath)
+	var fw *hdfs.FileWriter
+	err = fs.retry.doOnce(func() (err error) {
+		fw, err = fs.client.Create(fullpath)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, interpretQuotaException(err)
 	}
 
 	return newFile(nil, fw), nil
 }
 
 func (fs *hadoop) openFile(fullpath string) (extfs.File, error) {
-	fr, err := fs.client.Open(fullpath)
+	fr, err := fs.open(fullpath)
 	if err != nil {
 		return nil, err
 	}
@@ -223,10 +257,47 @@ func (fs *hadoop) openFile(fullpath string) (extfs.File, error) {
 }
 
 func (fs *hadoop) appendFile(fullpath string) (extfs.File, error) {
-	fw, err := fs.client.Append(fullpath)
+	var fw *hdfs.FileWriter
+	err := fs.retry.doOnce(func() (err error) {
+		fw, err = fs.client.Append(fullpath)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, interpretQuotaException(err)
 	}
 
 	return newFile(nil, fw), nil
 }
+
+func (fs *hadoop) stat(fullpath string) (fi os.FileInfo, err error) {
+	err = fs.retry.do(func() error {
+		fi, err = fs.client.Stat(fullpath)
+		return err
+	})
+
+	return fi, err
+}
+
+func (fs *hadoop) open(fullpath string) (fr *hdfs.FileReader, err error) {
+	err = fs.retry.do(func() error {
+		fr, err = fs.client.Open(fullpath)
+		return err
+	})
+
+	return fr, err
+}
+
+func (fs *hadoop) mkdirAll(fullpath string) error {
+	return fs.retry.doOnce(func() error {
+		return fs.client.MkdirAll(fullpath, defaultDirectoryMode)
+	})
+}
+
+func (fs *hadoop) contentSummary(fullpath string) (cs *hdfs.ContentSummary, err error) {
+	err = fs.retry.do(func() error {
+		cs, err = fs.client.GetContentSummary(fullpath)
+		return err
+	})
+
+	return cs, err
+}
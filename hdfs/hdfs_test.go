@@ -7,18 +7,53 @@ SPDX-License-Identifier: Apache-2.0
 package hdfs
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"os"
+	"path"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 
+	krb "github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/test/testdata"
 	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/hdfs/hdfstest"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs/hadoopconf"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
-const (
-	hadoopNamenode = "localhost:9000"
-)
+// hadoopNamenode is the namenode of the tests. An in-process cluster is
+// started unless EXTFS_HDFS_NAMENODE names the namenode of a real one.
+var hadoopNamenode = os.Getenv("EXTFS_HDFS_NAMENODE")
+
+func TestMain(m *testing.M) {
+	if hadoopNamenode != "" {
+		os.Exit(m.Run())
+	}
+
+	cluster, err := hdfstest.NewCluster()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	hadoopNamenode = cluster.Addr()
+
+	code := m.Run()
+	cluster.Close()
+	os.Exit(code)
+}
 
 func TestCreate(t *testing.T) {
 	fs, err := New("/cloudchain/test1", &extfs.Config{Addresses: []string{hadoopNamenode}})
@@ -81,3 +116,756 @@ func TestRemove(t *testing.T) {
 	err = fs.RemoveAll("")
 	assert.NoError(t, err)
 }
+
+func TestACL(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	f, err := fs.Create("acl.txt")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	acl := fs.(extfs.ACL)
+	entry := extfs.ACLEntry{Scope: extfs.ACLAccess, Type: extfs.ACLUser, Name: "nobody", Perm: 04}
+	err = acl.ModifyACLEntries("acl.txt", []extfs.ACLEntry{entry})
+	require.NoError(t, err)
+
+	status, err := acl.GetACL("acl.txt")
+	require.NoError(t, err)
+	assert.Contains(t, status.Entries, entry)
+
+	err = acl.RemoveACL("acl.txt")
+	require.NoError(t, err)
+
+	status, err = acl.GetACL("acl.txt")
+	require.NoError(t, err)
+	assert.Empty(t, status.Entries)
+}
+
+func TestOwner(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	f, err := fs.Create("owner.txt")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	c := fs.(extfs.Chowner)
+	require.NoError(t, c.Chown("owner.txt", "alice", "analysts"))
+	user, group, err := c.Owner("owner.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user)
+	assert.Equal(t, "analysts", group)
+
+	require.NoError(t, c.Chown("owner.txt", "", "staff"))
+	user, group, err = c.Owner("owner.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user)
+	assert.Equal(t, "staff", group)
+}
+
+func TestUsage(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	for _, name := range []string{"usage/a", "usage/b/c"} {
+		f, err := fs.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("Hello world"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+
+	cs, err := fs.(extfs.DiskUsage).Usage("usage")
+	require.NoError(t, err)
+	assert.Equal(t, int64(22), cs.Length)
+	assert.Equal(t, int64(2), cs.FileCount)
+	assert.Equal(t, int64(2), cs.DirectoryCount)
+	assert.True(t, cs.SpaceConsumed >= cs.Length)
+}
+
+func TestStatFS(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	stat, err := fs.(extfs.StatFS).StatFS()
+	require.NoError(t, err)
+	assert.NotZero(t, stat.Capacity)
+	assert.True(t, stat.Used+stat.Remaining <= stat.Capacity)
+}
+
+func TestQuota(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	err = fs.MkdirAll("quota", os.ModePerm)
+	require.NoError(t, err)
+
+	qm := fs.(QuotaManager)
+	err = qm.SetQuota("quota", 2, QuotaDontSet)
+	require.NoError(t, err)
+
+	quota, err := qm.GetQuota("quota")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), quota.NamespaceQuota)
+	assert.Equal(t, int64(1), quota.NamespaceUsed)
+
+	f, err := fs.Create("quota/a")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = fs.Create("quota/b")
+	require.Error(t, err)
+	qerr, ok := err.(*QuotaExceededError)
+	require.True(t, ok)
+	assert.False(t, qerr.Space)
+	assert.Equal(t, "create", qerr.Op)
+
+	err = qm.SetQuota("quota", QuotaReset, QuotaReset)
+	require.NoError(t, err)
+}
+
+type remoteError struct {
+	exception string
+	message   string
+}
+
+func (e *remoteError) Method() string    { return "addBlock" }
+func (e *remoteError) Desc() string      { return "ERROR_APPLICATION" }
+func (e *remoteError) Exception() string { return e.exception }
+func (e *remoteError) Message() string   { return e.message }
+func (e *remoteError) Error() string     { return e.exception }
+
+func TestInterpretQuotaException(t *testing.T) {
+	err := interpretQuotaException(&os.PathError{Op: "create", Path: "/quota/a", Err: &remoteError{
+		exception: dsQuotaExceededException,
+		message:   "The DiskSpace quota of /quota is exceeded\n\tat org.apache.hadoop...",
+	}})
+	qerr, ok := err.(*QuotaExceededError)
+	require.True(t, ok)
+	assert.True(t, qerr.Space)
+	assert.Equal(t, "create", qerr.Op)
+	assert.Equal(t, "/quota/a", qerr.Path)
+	assert.Equal(t, "create /quota/a: The DiskSpace quota of /quota is exceeded", qerr.Error())
+	var remoteErr hdfs.Error
+	assert.True(t, errors.As(err, &remoteErr))
+
+	err = &os.PathError{Op: "create", Path: "/quota/a", Err: &remoteError{exception: fileNotFoundException}}
+	assert.Equal(t, err, interpretQuotaException(err))
+	assert.Nil(t, interpretQuotaException(nil))
+}
+
+func TestSnapshot(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	f, err := fs.Create("snap/myfile.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s := fs.(Snapshotter)
+	require.NoError(t, s.AllowSnapshots("snap"))
+	defer s.DisallowSnapshots("snap")
+
+	path, err := s.CreateSnapshot("snap", "s1")
+	require.NoError(t, err)
+	assert.Equal(t, "snap/.snapshot/s1", path)
+
+	err = s.RenameSnapshot("snap", "s1", "s2")
+	require.NoError(t, err)
+	defer s.DeleteSnapshot("snap", "s2")
+
+	snapshots, err := s.Snapshots("snap")
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, "s2", snapshots[0].Name())
+
+	require.NoError(t, fs.Remove("snap/myfile.txt"))
+
+	sfs, err := s.OpenSnapshot("snap", "s2")
+	require.NoError(t, err)
+	defer sfs.Close()
+
+	f, err = sfs.Open("myfile.txt")
+	require.NoError(t, err)
+	defer f.Close()
+
+	data, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", string(data))
+
+	_, err = sfs.Create("other.txt")
+	assert.Equal(t, extfs.ErrReadOnly, err)
+
+	for _, name := range []string{"", ".", "..", "../..", "s2/.."} {
+		_, err = s.OpenSnapshot("snap", name)
+		assert.True(t, errors.Is(err, os.ErrInvalid), name)
+	}
+	_, err = s.CreateSnapshot("snap", "..")
+	assert.True(t, errors.Is(err, os.ErrInvalid))
+
+	generated, err := s.CreateSnapshot("snap", "")
+	require.NoError(t, err)
+	defer s.DeleteSnapshot("snap", strings.TrimPrefix(generated, "snap/.snapshot/"))
+	assert.True(t, strings.HasPrefix(generated, "snap/.snapshot/s"), generated)
+}
+
+func TestCreateWithOptions(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	sm := fs.(StorageManager)
+	opts := CreateOptions{Replication: 1, BlockSize: 1048576, Perm: 0600}
+	f, err := sm.CreateWithOptions("options.txt", opts)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	fi, err := fs.Stat("options.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	_, err = sm.CreateWithOptions("options.txt", opts)
+	assert.True(t, os.IsExist(err))
+
+	opts.Overwrite = true
+	opts.Perm = 0640
+	f, err = sm.CreateWithOptions("options.txt", opts)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Bye"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	f, err = fs.Open("options.txt")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Equal(t, "Bye", string(data))
+	fi, err = fs.Stat("options.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), fi.Mode().Perm())
+
+	require.NoError(t, fs.MkdirAll("dir", 0755))
+	_, err = sm.CreateWithOptions("dir", opts)
+	assert.True(t, os.IsExist(err))
+
+	err = sm.SetReplication("options.txt", 2)
+	assert.NoError(t, err)
+
+	err = sm.SetStoragePolicy("options.txt", "COLD")
+	require.NoError(t, err)
+	policy, err := sm.GetStoragePolicy("options.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "COLD", policy)
+}
+
+func TestBlockLocations(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	f, err := fs.Create("blocks.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	blocks, err := fs.(extfs.BlockLocator).BlockLocations("blocks.txt", 0, 11)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	assert.Equal(t, int64(0), blocks[0].Offset)
+	assert.Equal(t, int64(11), blocks[0].Length)
+	assert.NotEmpty(t, blocks[0].Hosts)
+}
+
+func TestChecksum(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	f, err := fs.Create("checksum.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cs, err := fs.(extfs.Checksummer).Checksum("checksum.txt", extfs.MD5MD5CRC32C)
+	require.NoError(t, err)
+
+	expected, err := extfs.ComputeChecksum(strings.NewReader("Hello world"), extfs.MD5MD5CRC32C, 0)
+	require.NoError(t, err)
+	assert.Equal(t, expected, cs)
+}
+
+func TestConcat(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	for _, name := range []string{"concat/target", "concat/part1", "concat/part2"} {
+		f, err := fs.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(path.Base(name) + ";"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+
+	err = fs.(extfs.Concatenator).Concat("concat/target", []string{"concat/part1", "concat/part2"})
+	require.NoError(t, err)
+
+	f, err := fs.Open("concat/target")
+	require.NoError(t, err)
+	defer f.Close()
+
+	data, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "target;part1;part2;", string(data))
+
+	_, err = fs.Stat("concat/part1")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMultipleBlocks(t *testing.T) {
+	fs, err := New("/cloudchain/test3", &extfs.Config{Addresses: []string{hadoopNamenode}})
+	require.NoError(t, err)
+	defer fs.Close()
+	defer fs.RemoveAll("")
+
+	const blockSize = 1048576
+	data := make([]byte, 2*blockSize+blockSize/2)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+
+	f, err := fs.(StorageManager).CreateWithOptions("large.bin", CreateOptions{BlockSize: blockSize})
+	require.NoError(t, err)
+	_, err = f.Write(data[:2*blockSize+100])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	f, err = fs.OpenFile("large.bin", os.O_WRONLY|os.O_APPEND, os.ModePerm)
+	require.NoError(t, err)
+	_, err = f.Write(data[2*blockSize+100:])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	blocks, err := fs.(extfs.BlockLocator).BlockLocations("large.bin", 0, int64(len(data)))
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+	assert.Equal(t, int64(2*blockSize), blocks[2].Offset)
+
+	f, err = fs.Open("large.bin")
+	require.NoError(t, err)
+	defer f.Close()
+
+	read, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, data, read)
+
+	_, err = f.Seek(blockSize-10, io.SeekStart)
+	require.NoError(t, err)
+	buf := make([]byte, 20)
+	_, err = io.ReadFull(f, buf)
+	require.NoError(t, err)
+	assert.Equal(t, data[blockSize-10:blockSize+10], buf)
+
+	cs, err := fs.(extfs.Checksummer).Checksum("large.bin", extfs.MD5MD5CRC32C)
+	require.NoError(t, err)
+	expected, err := extfs.ComputeChecksum(bytes.NewReader(data), extfs.MD5MD5CRC32C, blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, expected, cs)
+}
+
+func TestKerberos(t *testing.T) {
+	dir, err := ioutil.TempDir("", "extfs-kerberos-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFixture := func(name, data string, isHex bool) string {
+		b := []byte(data)
+		if isHex {
+			b, err = hex.DecodeString(data)
+			require.NoError(t, err)
+		}
+		p := path.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(p, b, 0600))
+		return p
+	}
+	krb5conf := writeFixture("krb5.conf", testdata.KRB5_CONF, false)
+	kt := writeFixture("testuser1.keytab", testdata.KEYTAB_TESTUSER1_TEST_GOKRB5, true)
+	ccache := writeFixture("krb5cc", testdata.CCACHE_TEST, true)
+
+	cfg := &extfs.Config{
+		KerberosKeytab:               kt,
+		KerberosConfig:               krb5conf,
+		KerberosServicePrincipalName: "nn/_HOST@TEST.GOKRB5",
+	}
+	options := hdfs.ClientOptions{}
+	require.NoError(t, configureKerberos(cfg, &options))
+	assert.Equal(t, "nn/_HOST", options.KerberosServicePrincipleName)
+	require.NotNil(t, options.KerberosClient)
+	assert.Equal(t, "testuser1", options.KerberosClient.Credentials.UserName())
+	assert.Equal(t, "TEST.GOKRB5", options.KerberosClient.Credentials.Domain())
+
+	cfg.KerberosPrincipal = "testuser1"
+	options = hdfs.ClientOptions{}
+	require.NoError(t, configureKerberos(cfg, &options))
+	assert.Equal(t, "TEST.GOKRB5", options.KerberosClient.Credentials.Domain())
+
+	cfg = &extfs.Config{
+		KerberosCCache:               "FILE:" + ccache,
+		KerberosConfig:               krb5conf,
+		KerberosServicePrincipalName: "nn/_HOST",
+	}
+	options = hdfs.ClientOptions{}
+	require.NoError(t, configureKerberos(cfg, &options))
+	assert.Equal(t, "testuser1", options.KerberosClient.Credentials.UserName())
+	assert.Equal(t, "TEST.GOKRB5", options.KerberosClient.Credentials.Domain())
+
+	cfg.KerberosServicePrincipalName = ""
+	options = hdfs.ClientOptions{}
+	assert.Error(t, configureKerberos(cfg, &options))
+
+	options = hdfs.ClientOptions{}
+	require.NoError(t, configureKerberos(&extfs.Config{}, &options))
+	assert.Nil(t, options.KerberosClient)
+}
+
+func TestProtection(t *testing.T) {
+	options := hdfs.ClientOptions{DataTransferProtection: extfs.ProtectionAuthentication}
+	cfg := &extfs.Config{DataTransferProtection: "Privacy", RPCProtection: extfs.ProtectionAuthentication}
+	require.NoError(t, configureProtection(cfg, &options))
+	assert.Equal(t, extfs.ProtectionPrivacy, options.DataTransferProtection)
+
+	options = hdfs.ClientOptions{DataTransferProtection: extfs.ProtectionIntegrity}
+	require.NoError(t, configureProtection(&extfs.Config{}, &options))
+	assert.Equal(t, extfs.ProtectionIntegrity, options.DataTransferProtection)
+
+	assert.Error(t, configureProtection(&extfs.Config{DataTransferProtection: "secret"}, &options))
+
+	options = hdfs.ClientOptions{KerberosClient: &krb.Client{}}
+	require.NoError(t, configureProtection(&extfs.Config{RPCProtection: "Privacy"}, &options))
+	assert.Equal(t, extfs.ProtectionPrivacy, options.RPCProtection)
+	assert.Error(t, configureProtection(&extfs.Config{RPCProtection: "secret"}, &options))
+
+	// Like the java client, the hadoop client skips the handshake with the
+	// datanodes listening on a privileged port unless the data transfer is
+	// encrypted, whatever the protection.
+	options = hdfs.ClientOptionsFromConf(hadoopconf.HadoopConf{
+		"fs.defaultFS":                 "hdfs://nn:8020",
+		"dfs.data.transfer.protection": extfs.ProtectionIntegrity,
+	})
+	require.NoError(t, configureProtection(&extfs.Config{DataTransferProtection: extfs.ProtectionPrivacy}, &options))
+	assert.True(t, reflect.ValueOf(options).FieldByName("skipSaslForPrivilegedDatanodePorts").Bool())
+	assert.Equal(t, []string{"nn:8020"}, options.Addresses)
+	assert.Equal(t, extfs.ProtectionPrivacy, options.DataTransferProtection)
+}
+
+func TestRPCProtection(t *testing.T) {
+	nn := newFakeNamenode(t)
+	defer nn.Close()
+
+	cfg := &extfs.Config{
+		Addresses:        []string{nn.Addr()},
+		DisableHadoopEnv: true,
+		RPCProtection:    extfs.ProtectionAuthentication,
+	}
+	fs, err := New("/", cfg)
+	require.NoError(t, err)
+	require.NoError(t, fs.Close())
+
+	cfg.RPCProtection = extfs.ProtectionPrivacy
+	_, err = New("/", cfg)
+	assert.Error(t, err)
+}
+
+func TestLoadHadoopConf(t *testing.T) {
+	dir, err := ioutil.TempDir("", "extfs-hadoopconf-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	coreSite := `<configuration>
+  <property><name>fs.defaultFS</name><value>hdfs://nn1:9000</value></property>
+  <property><name>hadoop.security.authentication</name><value>simple</value></property>
+</configuration>`
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "core-site.xml"), []byte(coreSite), 0644))
+
+	envDir, err := ioutil.TempDir("", "extfs-hadoopconf-env-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(envDir)
+	envSite := `<configuration>
+  <property><name>fs.defaultFS</name><value>hdfs://env:9000</value></property>
+</configuration>`
+	require.NoError(t, ioutil.WriteFile(path.Join(envDir, "core-site.xml"), []byte(envSite), 0644))
+	defer os.Setenv("HADOOP_CONF_DIR", os.Getenv("HADOOP_CONF_DIR"))
+	os.Setenv("HADOOP_CONF_DIR", envDir)
+
+	conf, err := loadHadoopConf(&extfs.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"env:9000"}, conf.Namenodes())
+
+	conf, err = loadHadoopConf(&extfs.Config{
+		HadoopConfDir: dir,
+		HadoopConf:    map[string]string{"dfs.data.transfer.protection": "privacy"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nn1:9000"}, conf.Namenodes())
+	assert.Equal(t, "simple", conf["hadoop.security.authentication"])
+	assert.Equal(t, "privacy", conf["dfs.data.transfer.protection"])
+
+	conf, err = loadHadoopConf(&extfs.Config{
+		DisableHadoopEnv: true,
+		HadoopConf:       map[string]string{"fs.defaultFS": "hdfs://nn2:9000"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nn2:9000"}, conf.Namenodes())
+
+	conf, err = loadHadoopConf(&extfs.Config{DisableHadoopEnv: true})
+	require.NoError(t, err)
+	assert.Nil(t, conf)
+
+	_, err = loadHadoopConf(&extfs.Config{HadoopConfDir: path.Join(dir, "missing")})
+	assert.Error(t, err)
+}
+
+func TestResolveNamenodes(t *testing.T) {
+	conf := hadoopconf.HadoopConf{
+		"fs.defaultFS":                           "hdfs://mycluster",
+		"dfs.nameservices":                       "mycluster,other,single",
+		"dfs.ha.namenodes.mycluster":             "nn1, nn2",
+		"dfs.namenode.rpc-address.mycluster.nn1": "host1:8020",
+		"dfs.namenode.rpc-address.mycluster.nn2": "host2:8020",
+		"dfs.ha.namenodes.other":                 "nn1",
+		"dfs.namenode.rpc-address.other.nn1":     "host3:8020",
+		"dfs.namenode.rpc-address.single":        "host4:8020",
+		"dfs.ha.namenodes.broken":                "nn1",
+	}
+	defaults := conf.Namenodes()
+
+	nns, err := resolveNamenodes(conf, defaults, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host1:8020", "host2:8020"}, nns)
+
+	nns, err = resolveNamenodes(conf, defaults, []string{"other"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host3:8020"}, nns)
+
+	nns, err = resolveNamenodes(conf, defaults, []string{"single"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host4:8020"}, nns)
+
+	nns, err = resolveNamenodes(conf, []string{"host1:8020"}, []string{"host5:8020"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host1:8020", "host5:8020"}, nns)
+
+	nns, err = resolveNamenodes(nil, nil, []string{"localhost:9000"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"localhost:9000"}, nns)
+
+	_, err = resolveNamenodes(conf, defaults, []string{"broken"})
+	assert.Error(t, err)
+
+	_, err = resolveNamenodes(conf, defaults, []string{"unknown"})
+	assert.EqualError(t, err, "Unknown nameservice unknown")
+	_, err = resolveNamenodes(nil, nil, []string{"mycluster"})
+	assert.EqualError(t, err, "Unknown nameservice mycluster")
+}
+
+func newFakeNamenode(t *testing.T) *hdfstest.Namenode {
+	nn, err := hdfstest.NewNamenode()
+	require.NoError(t, err)
+	nn.Handle("setPermission", func(req proto.Message) (proto.Message, error) {
+		return nil, nil
+	})
+	nn.Handle("getFileInfo", func(req proto.Message) (proto.Message, error) {
+		return nil, nil
+	})
+	return nn
+}
+
+func TestRetry(t *testing.T) {
+	nn := newFakeNamenode(t)
+	defer nn.Close()
+
+	cfg := &extfs.Config{
+		Addresses:        []string{nn.Addr()},
+		DisableHadoopEnv: true,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+	}
+	fs, err := New("/", cfg)
+	require.NoError(t, err)
+	defer fs.Close()
+
+	retriable := hdfstest.Fault{Exception: retriableException, Message: "try again"}
+	nn.InjectFault("setPermission", retriable, retriable)
+	assert.NoError(t, fs.Chmod("a", 0700))
+	assert.Equal(t, 3, nn.Calls("setPermission"))
+
+	nn.InjectFault("setPermission", retriable, retriable, retriable)
+	err = fs.Chmod("a", 0700)
+	var remoteErr hdfs.Error
+	require.True(t, errors.As(err, &remoteErr))
+	assert.Equal(t, retriableException, remoteErr.Exception())
+	assert.Equal(t, 6, nn.Calls("setPermission"))
+	assert.NoError(t, fs.Chmod("a", 0700))
+
+	nn.InjectFault("setPermission", hdfstest.Fault{Exception: fileNotFoundException})
+	err = fs.Chmod("a", 0700)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, 8, nn.Calls("setPermission"))
+
+	cfg.Retryable = func(err error) bool { return os.IsPermission(err) }
+	fs2, err := New("/", cfg)
+	require.NoError(t, err)
+	defer fs2.Close()
+
+	nn.InjectFault("getFileInfo", hdfstest.Fault{Exception: permissionDeniedException})
+	_, err = fs2.Stat("a")
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, 2, nn.Calls("getFileInfo"))
+}
+
+func TestRPCTimeout(t *testing.T) {
+	nn1 := newFakeNamenode(t)
+	defer nn1.Close()
+	nn2 := newFakeNamenode(t)
+	defer nn2.Close()
+
+	fs, err := New("/", &extfs.Config{
+		Addresses:        []string{nn1.Addr(), nn2.Addr()},
+		DisableHadoopEnv: true,
+		RPCTimeout:       100 * time.Millisecond,
+		MaxRetries:       1,
+		RetryBackoff:     time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	nn1.InjectFault("getFileInfo", hdfstest.Fault{Delay: time.Minute})
+	start := time.Now()
+	_, err = fs.Stat("a")
+	assert.True(t, os.IsNotExist(err))
+	assert.True(t, time.Since(start) < 10*time.Second)
+	assert.Equal(t, 1, nn1.Calls("getFileInfo"))
+	assert.Equal(t, 1, nn2.Calls("getFileInfo"))
+
+	// The namenode may have applied the request.
+	nn2.InjectFault("setPermission", hdfstest.Fault{Delay: time.Minute})
+	err = fs.Chmod("a", 0700)
+	assert.True(t, IsRetryable(err))
+	assert.Equal(t, 0, nn1.Calls("setPermission"))
+	assert.Equal(t, 1, nn2.Calls("setPermission"))
+}
+
+func TestRetryOnce(t *testing.T) {
+	nn1 := newFakeNamenode(t)
+	defer nn1.Close()
+	nn2 := newFakeNamenode(t)
+	defer nn2.Close()
+
+	fs, err := New("/", &extfs.Config{
+		Addresses:        []string{nn1.Addr(), nn2.Addr()},
+		DisableHadoopEnv: true,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	nn1.InjectFault("getFileInfo", hdfstest.Fault{Disconnect: true})
+	_, err = fs.Stat("a")
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, 1, nn1.Calls("getFileInfo"))
+	assert.Equal(t, 1, nn2.Calls("getFileInfo"))
+
+	nn2.InjectFault("setPermission", hdfstest.Fault{Disconnect: true})
+	err = fs.Chmod("a", 0700)
+	assert.True(t, IsRetryable(err))
+	assert.Equal(t, 0, nn1.Calls("setPermission"))
+	assert.Equal(t, 1, nn2.Calls("setPermission"))
+
+	dialer := &namenodeDialer{}
+	p := &retryPolicy{maxRetries: 2, backoff: time.Millisecond, retryable: IsRetryable, dialer: dialer}
+	var calls int
+	send := func(err error) func() error {
+		return func() error {
+			calls++
+			atomic.AddUint64(&dialer.writes, 1)
+			return err
+		}
+	}
+
+	calls = 0
+	assert.Equal(t, io.EOF, p.doOnce(send(io.EOF)))
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	assert.Equal(t, io.EOF, p.do(send(io.EOF)))
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	assert.Error(t, p.doOnce(send(&remoteError{exception: safeModeException})))
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	dialer.fail()
+	err = p.doOnce(func() error {
+		calls++
+		return errors.New("no available namenodes")
+	})
+	assert.True(t, IsRetryable(err))
+	assert.Equal(t, 3, calls)
+}
+
+func TestDialTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	l.Close()
+
+	start := time.Now()
+	_, err = New("/", &extfs.Config{
+		Addresses:        []string{addr},
+		DisableHadoopEnv: true,
+		DialTimeout:      100 * time.Millisecond,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+	})
+	assert.Error(t, err)
+	assert.True(t, IsRetryable(err))
+	assert.True(t, time.Since(start) < 10*time.Second)
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, IsRetryable(nil))
+	assert.True(t, IsRetryable(&remoteError{exception: standbyException}))
+	assert.True(t, IsRetryable(&os.PathError{Op: "stat", Path: "/a", Err: &remoteError{exception: safeModeException}}))
+	assert.False(t, IsRetryable(&remoteError{exception: fileNotFoundException}))
+	assert.True(t, IsRetryable(&net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}))
+	assert.True(t, IsRetryable(io.ErrUnexpectedEOF))
+	assert.False(t, IsRetryable(&os.PathError{Op: "remove", Path: "/a", Err: syscall.ENOTEMPTY}))
+	assert.False(t, IsRetryable(os.ErrNotExist))
+	assert.True(t, IsRetryable(&unavailableError{errors.New("no available namenodes")}))
+	assert.False(t, IsRetryable(errors.New("no available namenodes")))
+}
@@ -0,0 +1,1251 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfstest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultBlockSize   = 128 * 1024 * 1024
+	defaultReplication = 1
+	bytesPerChecksum   = 512
+	writePacketSize    = 64 * 1024
+	listingLimit       = 1000
+	capacity           = 1 << 40
+
+	superGroup = "supergroup"
+	blockPool  = "BP-hdfstest"
+
+	createFlagOverwrite = 2
+
+	hotStoragePolicy = 7
+)
+
+// The block storage policies of HDFS, by name.
+var storagePolicies = map[string]struct {
+	id    uint32
+	types []int
+}{
+	"HOT":          {7, []int{1}},
+	"COLD":         {2, []int{3}},
+	"WARM":         {5, []int{1, 3}},
+	"ALL_SSD":      {12, []int{2}},
+	"ONE_SSD":      {10, []int{2, 1}},
+	"LAZY_PERSIST": {15, []int{4, 1}},
+	"PROVIDED":     {1, []int{5, 1}},
+}
+
+// Cluster is an in-process HDFS cluster made of a Namenode, which keeps the
+// namespace in memory, and of a single datanode which stores the blocks.
+// It implements enough of the protocols for the hdfs package to run against
+// it, including permissions, quotas, snapshots, storage policies and ACLs.
+// Faults can still be injected in the calls of the namenode.
+type Cluster struct {
+	*Namenode
+	datanode *datanode
+
+	mu        sync.Mutex
+	root      *inode
+	blocks    map[uint64]*block
+	nextInode uint64
+	nextBlock uint64
+	genStamp  uint64
+}
+
+// call is a namenode call, made by the user of the connection.
+type call struct {
+	user string
+	req  proto.Message
+}
+
+func (c call) decode(v interface{}) error {
+	return decodeMessage(c.req, v)
+}
+
+// NewCluster starts a cluster listening on local ports. The root directory
+// is owned by the user running the process.
+func NewCluster() (*Cluster, error) {
+	nn, err := NewNamenode()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cluster{
+		Namenode:  nn,
+		root:      newDir("", 0755, "", superGroup, now()),
+		blocks:    make(map[uint64]*block),
+		nextInode: 16385,
+		nextBlock: 1073741825,
+		genStamp:  1001,
+	}
+	c.root.id = c.newInodeID()
+
+	c.datanode, err = newDatanode(c)
+	if err != nil {
+		nn.Close()
+		return nil, err
+	}
+
+	c.register()
+	return c, nil
+}
+
+// Close stops the namenode and the datanode.
+func (c *Cluster) Close() error {
+	err := c.Namenode.Close()
+	if dnErr := c.datanode.close(); err == nil {
+		err = dnErr
+	}
+
+	return err
+}
+
+func (c *Cluster) register() {
+	handlers := map[string]func(call) (interface{}, error){
+		"getFileInfo":            c.getFileInfo,
+		"getListing":             c.getListing,
+		"mkdirs":                 c.mkdirs,
+		"delete":                 c.delete,
+		"rename2":                c.rename2,
+		"setPermission":          c.setPermission,
+		"setOwner":               c.setOwner,
+		"setTimes":               c.setTimes,
+		"create":                 c.create,
+		"append":                 c.append,
+		"addBlock":               c.addBlock,
+		"updateBlockForPipeline": c.updateBlockForPipeline,
+		"complete":               c.complete,
+		"renewLease":             c.renewLease,
+		"getBlockLocations":      c.getBlockLocations,
+		"getServerDefaults":      c.getServerDefaults,
+		"getContentSummary":      c.getContentSummary,
+		"getFsStats":             c.getFsStats,
+		"setReplication":         c.setReplication,
+		"setStoragePolicy":       c.setStoragePolicy,
+		"getStoragePolicy":       c.getStoragePolicy,
+		"setQuota":               c.setQuota,
+		"concat":                 c.concat,
+		"allowSnapshot":          c.allowSnapshot,
+		"disallowSnapshot":       c.disallowSnapshot,
+		"createSnapshot":         c.createSnapshot,
+		"deleteSnapshot":         c.deleteSnapshot,
+		"renameSnapshot":         c.renameSnapshot,
+		"getAclStatus":           c.getAclStatus,
+		"setAcl":                 c.setAcl,
+		"modifyAclEntries":       c.modifyAclEntries,
+		"removeAcl":              c.removeAcl,
+	}
+
+	for method, h := range handlers {
+		method, h := method, h
+		c.handle(method, func(user string, req proto.Message) (proto.Message, error) {
+			c.mu.Lock()
+			v, err := h(call{user, req})
+			c.mu.Unlock()
+			if err != nil || v == nil {
+				return nil, err
+			}
+
+			resp, err := newMessage(messageName(method, "ResponseProto"), nil)
+			if err != nil {
+				return nil, err
+			}
+
+			return resp, encodeMessage(v, resp)
+		})
+	}
+}
+
+func now() uint64 {
+	return uint64(time.Now().UnixNano() / int64(time.Millisecond))
+}
+
+func (c *Cluster) newInodeID() uint64 {
+	c.nextInode++
+	return c.nextInode
+}
+
+// newBlock allocates an empty block.
+func (c *Cluster) newBlock() *block {
+	b := &block{id: c.nextBlock, genStamp: c.genStamp}
+	c.nextBlock++
+	c.genStamp++
+	c.blocks[b.id] = b
+
+	return b
+}
+
+// release frees the blocks of a tree.
+func (c *Cluster) release(n *inode) {
+	for _, b := range n.blocks {
+		delete(c.blocks, b.id)
+	}
+	for _, child := range n.children {
+		c.release(child)
+	}
+	for _, s := range n.snapshots {
+		c.release(s)
+	}
+}
+
+// stat returns the named inode, or nil if it does not exist.
+func (c *Cluster) stat(p string) (*lookup, error) {
+	l, err := c.resolve(p)
+	var remoteErr *Error
+	if errors.As(err, &remoteErr) && (remoteErr.Exception == fileNotFoundException ||
+		remoteErr.Exception == parentNotDirectoryException) {
+		return &lookup{path: path.Clean(p)}, nil
+	}
+
+	return l, err
+}
+
+func (c *Cluster) status(n *inode, name string) *fileStatus {
+	fs := &fileStatus{
+		FileType:         fileTypeFile,
+		Path:             []byte(name),
+		Permission:       fsPermission{n.perm},
+		Owner:            n.owner,
+		Group:            n.group,
+		ModificationTime: n.mtime,
+		AccessTime:       n.atime,
+		FileID:           n.id,
+		StoragePolicy:    n.storagePolicy,
+	}
+	if n.dir {
+		fs.FileType = fileTypeDir
+		fs.ChildrenNum = int32(len(n.children))
+		if n.snapshottable {
+			fs.Flags |= 0x08
+		}
+	} else {
+		fs.Length = n.length()
+		fs.BlockReplication = n.replication
+		fs.Blocksize = n.blockSize
+	}
+	if len(n.acl) != 0 {
+		fs.Flags |= 0x01
+	}
+
+	return fs
+}
+
+func (c *Cluster) locate(b *block, offset uint64) locatedBlock {
+	return locatedBlock{
+		B: extendedBlock{
+			PoolID:          blockPool,
+			BlockID:         b.id,
+			GenerationStamp: b.genStamp,
+			NumBytes:        uint64(len(b.data)),
+		},
+		Offset: offset,
+		Locs:   []datanodeInfo{{ID: c.datanode.id()}},
+	}
+}
+
+func (c *Cluster) locateBlocks(n *inode, offset, length uint64) *locatedBlocks {
+	lbs := &locatedBlocks{
+		FileLength:          n.length(),
+		Blocks:              []locatedBlock{},
+		UnderConstruction:   n.client != "",
+		IsLastBlockComplete: n.client == "",
+	}
+
+	var pos uint64
+	for _, b := range n.blocks {
+		size := uint64(len(b.data))
+		if pos+size > offset && pos < offset+length || size == 0 && pos == offset {
+			lbs.Blocks = append(lbs.Blocks, c.locate(b, pos))
+		}
+		pos += size
+	}
+	if len(n.blocks) != 0 {
+		last := c.locate(n.blocks[len(n.blocks)-1], pos-uint64(len(n.blocks[len(n.blocks)-1].data)))
+		lbs.LastBlock = &last
+	}
+
+	return lbs
+}
+
+func (c *Cluster) getFileInfo(cl call) (interface{}, error) {
+	var req struct {
+		Src string `json:"src"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.stat(req.Src)
+	if err != nil || l.node == nil {
+		return map[string]interface{}{}, err
+	}
+
+	return map[string]interface{}{"fs": c.status(l.node, "")}, nil
+}
+
+func (c *Cluster) getListing(cl call) (interface{}, error) {
+	var req struct {
+		Src        string `json:"src"`
+		StartAfter []byte `json:"startAfter"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.stat(req.Src)
+	if err != nil || l.node == nil {
+		return map[string]interface{}{}, err
+	}
+
+	listing := []*fileStatus{}
+	remaining := 0
+	if !l.node.dir {
+		listing = append(listing, c.status(l.node, ""))
+	} else {
+		for _, child := range l.node.sortedChildren() {
+			if child.name <= string(req.StartAfter) {
+				continue
+			}
+			if len(listing) == listingLimit {
+				remaining++
+				continue
+			}
+			listing = append(listing, c.status(child, child.name))
+		}
+	}
+
+	return map[string]interface{}{
+		"dirList": map[string]interface{}{"partialListing": listing, "remainingEntries": remaining},
+	}, nil
+}
+
+func (c *Cluster) mkdirs(cl call) (interface{}, error) {
+	var req struct {
+		Src          string       `json:"src"`
+		Masked       fsPermission `json:"masked"`
+		CreateParent bool         `json:"createParent"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.resolveWritable(req.Src)
+	if err != nil {
+		var remoteErr *Error
+		if !req.CreateParent || !errors.As(err, &remoteErr) || remoteErr.Exception != fileNotFoundException {
+			return nil, err
+		}
+	}
+	if err == nil && l.node != nil {
+		if !l.node.dir {
+			return nil, &Error{fileAlreadyExistsException, "Path is not a directory: " + l.path}
+		}
+		return map[string]interface{}{"result": true}, nil
+	}
+
+	// Walk from the root, creating the missing directories.
+	names := strings.Split(strings.TrimPrefix(path.Clean(req.Src), "/"), "/")
+	parents := []*inode{c.root}
+	for i, name := range names {
+		dir := parents[len(parents)-1]
+		child, ok := dir.children[name]
+		if !ok {
+			if err := checkQuota(parents, int64(len(names)-i), 0); err != nil {
+				return nil, err
+			}
+
+			child = newDir(name, req.Masked.Perm, cl.user, superGroup, now())
+			child.id = c.newInodeID()
+			dir.children[name] = child
+			dir.mtime = child.mtime
+		} else if !child.dir {
+			return nil, &Error{parentNotDirectoryException, "Parent path is not a directory: " + child.name}
+		}
+		parents = append(parents, child)
+	}
+
+	return map[string]interface{}{"result": true}, nil
+}
+
+func (c *Cluster) delete(cl call) (interface{}, error) {
+	var req struct {
+		Src       string `json:"src"`
+		Recursive bool   `json:"recursive"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.stat(req.Src)
+	if err == nil && l.snapshot {
+		err = &Error{snapshotAccessControlException, "Modification on a read-only snapshot is disallowed"}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if l.node == nil {
+		return map[string]interface{}{"result": false}, nil
+	}
+	if l.node == c.root {
+		return nil, &Error{ioException, "Cannot delete the root directory"}
+	}
+	if l.node.dir && len(l.node.children) != 0 && !req.Recursive {
+		return nil, &Error{pathIsNotEmptyDirException, "`" + l.path + " is non empty': Directory is not empty"}
+	}
+	if dir := snapshottedDir(l.node, l.path); dir != "" {
+		return nil, &Error{snapshotException, fmt.Sprintf(
+			"The directory %s cannot be deleted since %s is snapshottable and already has snapshots", l.path, dir)}
+	}
+
+	c.release(l.node)
+	parent := l.parent()
+	delete(parent.children, l.node.name)
+	parent.mtime = now()
+
+	return map[string]interface{}{"result": true}, nil
+}
+
+// snapshottedDir returns the path of a directory of the tree which has
+// snapshots, or "" if there is none.
+func snapshottedDir(n *inode, p string) string {
+	if len(n.snapshots) != 0 {
+		return p
+	}
+	for name, child := range n.children {
+		if dir := snapshottedDir(child, path.Join(p, name)); dir != "" {
+			return dir
+		}
+	}
+
+	return ""
+}
+
+func (c *Cluster) rename2(cl call) (interface{}, error) {
+	var req struct {
+		Src           string `json:"src"`
+		Dst           string `json:"dst"`
+		OverwriteDest bool   `json:"overwriteDest"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	src, err := c.resolveWritable(req.Src)
+	if err != nil {
+		return nil, err
+	}
+	if src.node == nil {
+		return nil, &Error{fileNotFoundException, "rename source " + src.path + " is not found."}
+	}
+	if src.node == c.root {
+		return nil, &Error{ioException, "rename source cannot be the root"}
+	}
+
+	dst, err := c.resolveWritable(req.Dst)
+	if err != nil {
+		return nil, err
+	}
+	if dst.path == src.path {
+		return map[string]interface{}{}, nil
+	}
+	if strings.HasPrefix(dst.path, src.path+"/") {
+		return nil, &Error{ioException, "Rename destination " + dst.path + " is a directory or file under source " + src.path}
+	}
+	parent := dst.parent()
+	if parent == nil || dst.node == c.root {
+		return nil, &Error{fileAlreadyExistsException, "rename destination " + dst.path + " already exists"}
+	}
+
+	if dst.node != nil {
+		switch {
+		case !req.OverwriteDest:
+			return nil, &Error{fileAlreadyExistsException, "rename destination " + dst.path + " already exists"}
+		case dst.node.dir != src.node.dir:
+			return nil, &Error{ioException, "Source " + src.path + " and destination " + dst.path + " must both be directories or files"}
+		case dst.node.dir && len(dst.node.children) != 0:
+			return nil, &Error{ioException, "rename destination directory is not empty: " + dst.path}
+		}
+	}
+
+	// Only the directories which do not already hold the source are charged.
+	shared := 0
+	for shared < len(src.parents) && shared < len(dst.parents) && src.parents[shared] == dst.parents[shared] {
+		shared++
+	}
+	count, space := src.node.usage()
+	if err := checkQuota(dst.parents[shared:], count, space); err != nil {
+		return nil, err
+	}
+
+	if dst.node != nil {
+		c.release(dst.node)
+	}
+	delete(src.parent().children, src.node.name)
+	src.node.name = dst.name()
+	parent.children[src.node.name] = src.node
+	src.parent().mtime, parent.mtime = now(), now()
+
+	return map[string]interface{}{}, nil
+}
+
+func (c *Cluster) setPermission(cl call) (interface{}, error) {
+	var req struct {
+		Src        string       `json:"src"`
+		Permission fsPermission `json:"permission"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	n.perm = req.Permission.Perm
+	return nil, nil
+}
+
+func (c *Cluster) setOwner(cl call) (interface{}, error) {
+	var req struct {
+		Src       string `json:"src"`
+		Username  string `json:"username"`
+		Groupname string `json:"groupname"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Username != "" {
+		n.owner = req.Username
+	}
+	if req.Groupname != "" {
+		n.group = req.Groupname
+	}
+
+	return nil, nil
+}
+
+func (c *Cluster) setTimes(cl call) (interface{}, error) {
+	var req struct {
+		Src   string `json:"src"`
+		Mtime uint64 `json:"mtime,string"`
+		Atime uint64 `json:"atime,string"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	// -1 leaves the time unchanged.
+	if req.Mtime != math.MaxUint64 {
+		n.mtime = req.Mtime
+	}
+	if req.Atime != math.MaxUint64 {
+		n.atime = req.Atime
+	}
+
+	return nil, nil
+}
+
+// writableNode returns the named inode, which must exist and not be part of
+// a snapshot.
+func (c *Cluster) writableNode(p string) (*inode, error) {
+	l, err := c.resolveWritable(p)
+	if err != nil {
+		return nil, err
+	}
+	if l.node == nil {
+		return nil, &Error{fileNotFoundException, "File does not exist: " + l.path}
+	}
+
+	return l.node, nil
+}
+
+// writtenFile returns the named file, which must be written by client.
+func (c *Cluster) writtenFile(p, client string) (*inode, error) {
+	n, err := c.writableNode(p)
+	if err != nil {
+		return nil, err
+	}
+	if n.dir || n.client != client {
+		return nil, &Error{leaseExpiredException, "No lease on " + p + ": File is not open for writing"}
+	}
+
+	return n, nil
+}
+
+func (c *Cluster) create(cl call) (interface{}, error) {
+	var req struct {
+		Src           string       `json:"src"`
+		Masked        fsPermission `json:"masked"`
+		ClientName    string       `json:"clientName"`
+		CreateFlag    uint32       `json:"createFlag"`
+		CreateParent  bool         `json:"createParent"`
+		Replication   uint32       `json:"replication"`
+		BlockSize     uint64       `json:"blockSize,string"`
+		StoragePolicy string       `json:"storagePolicy"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.resolveWritable(req.Src)
+	if err != nil {
+		return nil, err
+	}
+	parent := l.parent()
+	if parent == nil {
+		return nil, &Error{fileAlreadyExistsException, l.path + " already exists as a directory"}
+	}
+
+	if l.node != nil {
+		switch {
+		case l.node.dir:
+			return nil, &Error{fileAlreadyExistsException, l.path + " already exists as a directory"}
+		case l.node.client != "":
+			return nil, &Error{alreadyBeingCreatedException, "Failed to create file " + l.path +
+				" for " + req.ClientName + " because this file lease is currently owned by " + l.node.client}
+		case req.CreateFlag&createFlagOverwrite == 0:
+			return nil, &Error{fileAlreadyExistsException, l.path + " for client already exists"}
+		}
+	} else if err := checkQuota(l.parents, 1, 0); err != nil {
+		return nil, err
+	}
+
+	policy := uint32(0)
+	if req.StoragePolicy != "" {
+		p, ok := storagePolicies[req.StoragePolicy]
+		if !ok {
+			return nil, &Error{illegalArgumentException, "Cannot find a block policy with the name " + req.StoragePolicy}
+		}
+		policy = p.id
+	}
+
+	if l.node != nil {
+		c.release(l.node)
+	}
+
+	n := &inode{
+		name:          l.name(),
+		perm:          req.Masked.Perm,
+		owner:         cl.user,
+		group:         superGroup,
+		mtime:         now(),
+		id:            c.newInodeID(),
+		replication:   req.Replication,
+		blockSize:     req.BlockSize,
+		client:        req.ClientName,
+		storagePolicy: policy,
+	}
+	n.atime = n.mtime
+	parent.children[n.name] = n
+	parent.mtime = n.mtime
+
+	return map[string]interface{}{"fs": c.status(n, "")}, nil
+}
+
+func (c *Cluster) append(cl call) (interface{}, error) {
+	var req struct {
+		Src        string `json:"src"`
+		ClientName string `json:"clientName"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+	if n.dir {
+		return nil, &Error{fileNotFoundException, "Failed to append to non-existent file " + req.Src}
+	}
+	if n.client != "" {
+		return nil, &Error{alreadyBeingCreatedException, "Failed to append " + req.Src +
+			" for " + req.ClientName + " because this file lease is currently owned by " + n.client}
+	}
+
+	n.client = req.ClientName
+	resp := map[string]interface{}{"stat": c.status(n, "")}
+
+	// The last block is returned if it is not full.
+	if len(n.blocks) != 0 {
+		last := n.blocks[len(n.blocks)-1]
+		if uint64(len(last.data)) < n.blockSize {
+			resp["block"] = c.locate(last, n.length()-uint64(len(last.data)))
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *Cluster) addBlock(cl call) (interface{}, error) {
+	var req struct {
+		Src        string `json:"src"`
+		ClientName string `json:"clientName"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.resolveWritable(req.Src)
+	if err != nil {
+		return nil, err
+	}
+	n, err := c.writtenFile(req.Src, req.ClientName)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkQuota(l.parents, 0, int64(n.blockSize)*int64(n.replication)); err != nil {
+		return nil, err
+	}
+
+	offset := n.length()
+	b := c.newBlock()
+	n.blocks = append(n.blocks, b)
+
+	return map[string]interface{}{"block": c.locate(b, offset)}, nil
+}
+
+func (c *Cluster) updateBlockForPipeline(cl call) (interface{}, error) {
+	var req struct {
+		Block extendedBlock `json:"block"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	b, ok := c.blocks[req.Block.BlockID]
+	if !ok {
+		return nil, &Error{ioException, fmt.Sprintf("Block %d does not exist", req.Block.BlockID)}
+	}
+
+	return map[string]interface{}{"block": c.locate(b, 0)}, nil
+}
+
+func (c *Cluster) complete(cl call) (interface{}, error) {
+	var req struct {
+		Src        string `json:"src"`
+		ClientName string `json:"clientName"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writtenFile(req.Src, req.ClientName)
+	if err != nil {
+		return nil, err
+	}
+
+	n.client = ""
+	n.mtime = now()
+
+	return map[string]interface{}{"result": true}, nil
+}
+
+func (c *Cluster) renewLease(cl call) (interface{}, error) {
+	return nil, nil
+}
+
+func (c *Cluster) getBlockLocations(cl call) (interface{}, error) {
+	var req struct {
+		Src    string `json:"src"`
+		Offset uint64 `json:"offset,string"`
+		Length uint64 `json:"length,string"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.resolve(req.Src)
+	if err != nil {
+		return nil, err
+	}
+	if l.node == nil || l.node.dir {
+		return nil, &Error{fileNotFoundException, "File does not exist: " + l.path}
+	}
+
+	return map[string]interface{}{"locations": c.locateBlocks(l.node, req.Offset, req.Length)}, nil
+}
+
+func (c *Cluster) getServerDefaults(cl call) (interface{}, error) {
+	return map[string]interface{}{
+		"serverDefaults": map[string]interface{}{
+			"blockSize":        strconv.Itoa(defaultBlockSize),
+			"bytesPerChecksum": bytesPerChecksum,
+			"writePacketSize":  writePacketSize,
+			"replication":      defaultReplication,
+			"fileBufferSize":   4096,
+			"checksumType":     checksumTypeCRC32C,
+			"policyId":         hotStoragePolicy,
+		},
+	}, nil
+}
+
+func (c *Cluster) getContentSummary(cl call) (interface{}, error) {
+	var req struct {
+		Path string `json:"path"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.resolveExisting(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &contentSummary{
+		Quota:      uint64(l.node.nsQuota),
+		SpaceQuota: uint64(l.node.ssQuota),
+	}
+	var walk func(n *inode)
+	walk = func(n *inode) {
+		if !n.dir {
+			cs.FileCount++
+			cs.Length += n.length()
+			cs.SpaceConsumed += n.length() * uint64(n.replication)
+			return
+		}
+
+		cs.DirectoryCount++
+		for _, child := range n.children {
+			walk(child)
+		}
+	}
+	walk(l.node)
+
+	return map[string]interface{}{"summary": cs}, nil
+}
+
+func (c *Cluster) getFsStats(cl call) (interface{}, error) {
+	var used uint64
+	for _, b := range c.blocks {
+		used += uint64(len(b.data))
+	}
+
+	zero := "0"
+	return map[string]interface{}{
+		"capacity":         strconv.FormatUint(capacity, 10),
+		"used":             strconv.FormatUint(used, 10),
+		"remaining":        strconv.FormatUint(capacity-used, 10),
+		"under_replicated": zero,
+		"corrupt_blocks":   zero,
+		"missing_blocks":   zero,
+	}, nil
+}
+
+func (c *Cluster) setReplication(cl call) (interface{}, error) {
+	var req struct {
+		Src         string `json:"src"`
+		Replication uint32 `json:"replication"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+	if n.dir {
+		return map[string]interface{}{"result": false}, nil
+	}
+
+	n.replication = req.Replication
+	return map[string]interface{}{"result": true}, nil
+}
+
+func (c *Cluster) setStoragePolicy(cl call) (interface{}, error) {
+	var req struct {
+		Src        string `json:"src"`
+		PolicyName string `json:"policyName"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := storagePolicies[req.PolicyName]
+	if !ok {
+		return nil, &Error{illegalArgumentException, "Cannot find a block policy with the name " + req.PolicyName}
+	}
+
+	n.storagePolicy = p.id
+	return nil, nil
+}
+
+func (c *Cluster) getStoragePolicy(cl call) (interface{}, error) {
+	var req struct {
+		Path string `json:"path"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.resolveExisting(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The policy is inherited from the closest ancestor which has one.
+	id := l.node.storagePolicy
+	for i := len(l.parents) - 1; id == 0 && i >= 0; i-- {
+		id = l.parents[i].storagePolicy
+	}
+	if id == 0 {
+		id = hotStoragePolicy
+	}
+
+	for name, p := range storagePolicies {
+		if p.id == id {
+			return map[string]interface{}{
+				"storagePolicy": map[string]interface{}{
+					"policyId":       p.id,
+					"name":           name,
+					"creationPolicy": map[string]interface{}{"storageTypes": p.types},
+				},
+			}, nil
+		}
+	}
+
+	return nil, &Error{ioException, fmt.Sprintf("Unknown storage policy %d", id)}
+}
+
+func (c *Cluster) setQuota(cl call) (interface{}, error) {
+	var req struct {
+		Path              string `json:"path"`
+		NamespaceQuota    uint64 `json:"namespaceQuota,string"`
+		StoragespaceQuota uint64 `json:"storagespaceQuota,string"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	if !n.dir {
+		return nil, &Error{fileNotFoundException, "Cannot set quota on a file: " + req.Path}
+	}
+
+	// math.MaxInt64 leaves a quota unchanged and -1 removes it.
+	if req.NamespaceQuota != math.MaxInt64 {
+		n.nsQuota = int64(req.NamespaceQuota)
+	}
+	if req.StoragespaceQuota != math.MaxInt64 {
+		n.ssQuota = int64(req.StoragespaceQuota)
+	}
+
+	return nil, nil
+}
+
+func (c *Cluster) concat(cl call) (interface{}, error) {
+	var req struct {
+		Trg  string   `json:"trg"`
+		Srcs []string `json:"srcs"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	trg, err := c.writableNode(req.Trg)
+	if err != nil {
+		return nil, err
+	}
+	if trg.dir || trg.client != "" {
+		return nil, &Error{ioException, "The target " + req.Trg + " must be a closed file"}
+	}
+
+	srcs := make([]*lookup, 0, len(req.Srcs))
+	for _, src := range req.Srcs {
+		l, err := c.resolveWritable(src)
+		if err != nil {
+			return nil, err
+		}
+		if l.node == nil {
+			return nil, &Error{fileNotFoundException, "File does not exist: " + l.path}
+		}
+		if l.node.dir || l.node.client != "" || l.node == trg {
+			return nil, &Error{illegalArgumentException, "The source " + l.path + " must be a closed file other than the target"}
+		}
+		srcs = append(srcs, l)
+	}
+
+	for _, l := range srcs {
+		trg.blocks = append(trg.blocks, l.node.blocks...)
+		delete(l.parent().children, l.node.name)
+	}
+	trg.mtime = now()
+
+	return nil, nil
+}
+
+func (c *Cluster) snapshotRoot(p string) (*inode, error) {
+	n, err := c.writableNode(p)
+	if err != nil {
+		return nil, err
+	}
+	if !n.snapshottable {
+		return nil, &Error{snapshotException, "Directory is not a snapshottable directory: " + p}
+	}
+
+	return n, nil
+}
+
+func findSnapshot(dir *inode, name string) int {
+	for i, s := range dir.snapshots {
+		if s.name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (c *Cluster) allowSnapshot(cl call) (interface{}, error) {
+	var req struct {
+		SnapshotRoot string `json:"snapshotRoot"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.SnapshotRoot)
+	if err != nil {
+		return nil, err
+	}
+	if !n.dir {
+		return nil, &Error{fileNotFoundException, "Path is not a directory: " + req.SnapshotRoot}
+	}
+
+	n.snapshottable = true
+	return nil, nil
+}
+
+func (c *Cluster) disallowSnapshot(cl call) (interface{}, error) {
+	var req struct {
+		SnapshotRoot string `json:"snapshotRoot"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.SnapshotRoot)
+	if err != nil {
+		return nil, err
+	}
+	if len(n.snapshots) != 0 {
+		return nil, &Error{snapshotException, "The directory " + req.SnapshotRoot +
+			" has snapshot(s). Please redo the operation after removing all the snapshots."}
+	}
+
+	n.snapshottable = false
+	return nil, nil
+}
+
+func (c *Cluster) createSnapshot(cl call) (interface{}, error) {
+	var req struct {
+		SnapshotRoot string `json:"snapshotRoot"`
+		SnapshotName string `json:"snapshotName"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.snapshotRoot(req.SnapshotRoot)
+	if err != nil {
+		return nil, err
+	}
+	name := req.SnapshotName
+	if name == "" {
+		name = time.Now().UTC().Format("s20060102-150405.000")
+	}
+	if findSnapshot(n, name) >= 0 {
+		return nil, &Error{snapshotException, "Failed to add snapshot: there is already a snapshot with the same name \"" + name + "\"."}
+	}
+
+	s := c.copyTree(n, name)
+	s.mtime = now()
+	n.snapshots = append(n.snapshots, s)
+
+	return map[string]interface{}{
+		"snapshotPath": path.Join(path.Clean(req.SnapshotRoot), snapshotDirName, name),
+	}, nil
+}
+
+func (c *Cluster) deleteSnapshot(cl call) (interface{}, error) {
+	var req struct {
+		SnapshotRoot string `json:"snapshotRoot"`
+		SnapshotName string `json:"snapshotName"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.snapshotRoot(req.SnapshotRoot)
+	if err != nil {
+		return nil, err
+	}
+	i := findSnapshot(n, req.SnapshotName)
+	if i < 0 {
+		return nil, &Error{snapshotException, "Cannot delete snapshot " + req.SnapshotName +
+			" from path " + req.SnapshotRoot + ": the snapshot does not exist."}
+	}
+
+	c.release(n.snapshots[i])
+	n.snapshots = append(n.snapshots[:i], n.snapshots[i+1:]...)
+
+	return nil, nil
+}
+
+func (c *Cluster) renameSnapshot(cl call) (interface{}, error) {
+	var req struct {
+		SnapshotRoot    string `json:"snapshotRoot"`
+		SnapshotOldName string `json:"snapshotOldName"`
+		SnapshotNewName string `json:"snapshotNewName"`
+	}
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.snapshotRoot(req.SnapshotRoot)
+	if err != nil {
+		return nil, err
+	}
+	i := findSnapshot(n, req.SnapshotOldName)
+	if i < 0 {
+		return nil, &Error{snapshotException, "The snapshot " + req.SnapshotOldName +
+			" does not exist for directory " + req.SnapshotRoot}
+	}
+	if findSnapshot(n, req.SnapshotNewName) >= 0 {
+		return nil, &Error{snapshotException, "The snapshot " + req.SnapshotNewName +
+			" already exists for directory " + req.SnapshotRoot}
+	}
+
+	n.snapshots[i].name = req.SnapshotNewName
+	return nil, nil
+}
+
+type aclRequest struct {
+	Src     string     `json:"src"`
+	ACLSpec []aclEntry `json:"aclSpec"`
+}
+
+func (c *Cluster) getAclStatus(cl call) (interface{}, error) {
+	var req aclRequest
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	l, err := c.resolveExisting(req.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"result": map[string]interface{}{
+			"owner":      l.node.owner,
+			"group":      l.node.group,
+			"sticky":     l.node.perm&01000 != 0,
+			"entries":    append([]aclEntry{}, l.node.acl...),
+			"permission": fsPermission{l.node.perm},
+		},
+	}, nil
+}
+
+func (c *Cluster) setAcl(cl call) (interface{}, error) {
+	var req aclRequest
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	n.acl = nil
+	modifyACL(n, req.ACLSpec)
+	return nil, nil
+}
+
+func (c *Cluster) modifyAclEntries(cl call) (interface{}, error) {
+	var req aclRequest
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	modifyACL(n, req.ACLSpec)
+	return nil, nil
+}
+
+func (c *Cluster) removeAcl(cl call) (interface{}, error) {
+	var req aclRequest
+	if err := cl.decode(&req); err != nil {
+		return nil, err
+	}
+
+	n, err := c.writableNode(req.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	n.acl = nil
+	return nil, nil
+}
+
+// modifyACL merges entries into the ACL of n. The unnamed access entries of
+// the owner, the group and the others are the permission bits of the inode.
+func modifyACL(n *inode, entries []aclEntry) {
+	for _, e := range entries {
+		if e.Scope == aclScopeAccess && e.Name == "" && e.Type != aclTypeMask {
+			shift := map[int]uint{aclTypeUser: 6, aclTypeGroup: 3, aclTypeOther: 0}[e.Type]
+			n.perm = n.perm&^(07<<shift) | e.Permissions<<shift
+			continue
+		}
+
+		replaced := false
+		for i, cur := range n.acl {
+			if cur.Scope == e.Scope && cur.Type == e.Type && cur.Name == e.Name {
+				n.acl[i], replaced = e, true
+			}
+		}
+		if !replaced {
+			n.acl = append(n.acl, e)
+		}
+	}
+}
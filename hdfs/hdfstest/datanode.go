@@ -0,0 +1,449 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfstest
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	dataTransferVersion = 0x1c
+	writeBlockOp        = 0x50
+	readBlockOp         = 0x51
+	checksumBlockOp     = 0x55
+
+	heartbeatSeqno = -1
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+type baseHeader struct {
+	Block extendedBlock `json:"block"`
+}
+
+type opReadBlock struct {
+	Header struct {
+		BaseHeader baseHeader `json:"baseHeader"`
+	} `json:"header"`
+	Offset uint64 `json:"offset,string"`
+	Len    uint64 `json:"len,string"`
+}
+
+type opWriteBlock struct {
+	Header struct {
+		BaseHeader baseHeader `json:"baseHeader"`
+	} `json:"header"`
+}
+
+type opBlockChecksum struct {
+	Header baseHeader `json:"header"`
+}
+
+type packetHeader struct {
+	OffsetInBlock     int64 `json:"offsetInBlock,string"`
+	Seqno             int64 `json:"seqno,string"`
+	LastPacketInBlock bool  `json:"lastPacketInBlock"`
+	DataLen           int32 `json:"dataLen"`
+}
+
+type pipelineAck struct {
+	Seqno int64 `json:"seqno,string"`
+	Reply []int `json:"reply"`
+}
+
+// datanode serves the blocks of a cluster with the data transfer protocol.
+// The data is checksummed with CRC32C over chunks of 512 bytes.
+type datanode struct {
+	cluster  *Cluster
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func newDatanode(c *Cluster) (*datanode, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	dn := &datanode{cluster: c, listener: l, conns: make(map[net.Conn]struct{})}
+	dn.wg.Add(1)
+	go dn.serve()
+
+	return dn, nil
+}
+
+// id returns the identity of the datanode, as returned to the clients.
+func (dn *datanode) id() datanodeID {
+	addr := dn.listener.Addr().(*net.TCPAddr)
+	return datanodeID{
+		IPAddr:       addr.IP.String(),
+		HostName:     "localhost",
+		DatanodeUUID: "hdfstest-datanode",
+		XferPort:     uint32(addr.Port),
+	}
+}
+
+func (dn *datanode) close() error {
+	err := dn.listener.Close()
+
+	dn.mu.Lock()
+	for conn := range dn.conns {
+		conn.Close()
+	}
+	dn.mu.Unlock()
+
+	dn.wg.Wait()
+	return err
+}
+
+func (dn *datanode) serve() {
+	defer dn.wg.Done()
+
+	for {
+		conn, err := dn.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		dn.mu.Lock()
+		dn.conns[conn] = struct{}{}
+		dn.mu.Unlock()
+
+		dn.wg.Add(1)
+		go func() {
+			defer dn.wg.Done()
+			dn.serveConn(conn)
+
+			dn.mu.Lock()
+			delete(dn.conns, conn)
+			dn.mu.Unlock()
+			conn.Close()
+		}()
+	}
+}
+
+func (dn *datanode) serveConn(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+
+	header := make([]byte, 3)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return err
+	}
+	if binary.BigEndian.Uint16(header) != dataTransferVersion {
+		return errors.New("unsupported data transfer version")
+	}
+
+	switch header[2] {
+	case readBlockOp:
+		var op opReadBlock
+		if err := readOp(r, "hadoop.hdfs.OpReadBlockProto", &op); err != nil {
+			return err
+		}
+		return dn.readBlock(conn, &op)
+	case writeBlockOp:
+		var op opWriteBlock
+		if err := readOp(r, "hadoop.hdfs.OpWriteBlockProto", &op); err != nil {
+			return err
+		}
+		return dn.writeBlock(r, conn, &op)
+	case checksumBlockOp:
+		var op opBlockChecksum
+		if err := readOp(r, "hadoop.hdfs.OpBlockChecksumProto", &op); err != nil {
+			return err
+		}
+		return dn.blockChecksum(conn, &op)
+	default:
+		return fmt.Errorf("unsupported op %#x", header[2])
+	}
+}
+
+// data returns a copy of the data of a block.
+func (dn *datanode) data(b extendedBlock) ([]byte, bool) {
+	dn.cluster.mu.Lock()
+	defer dn.cluster.mu.Unlock()
+
+	blk, ok := dn.cluster.blocks[b.BlockID]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]byte(nil), blk.data...), true
+}
+
+func (dn *datanode) readBlock(w io.Writer, op *opReadBlock) error {
+	b := op.Header.BaseHeader.Block
+	data, ok := dn.data(b)
+	if !ok || op.Offset > uint64(len(data)) {
+		return writeOpResponse(w, map[string]interface{}{
+			"status":  statusError,
+			"message": fmt.Sprintf("Replica not found for block %d", b.BlockID),
+		})
+	}
+
+	// Reads start at a chunk boundary.
+	start := op.Offset - op.Offset%bytesPerChecksum
+	end := uint64(len(data))
+	if op.Offset+op.Len < end {
+		end = op.Offset + op.Len
+	}
+
+	err := writeOpResponse(w, map[string]interface{}{
+		"status": statusSuccess,
+		"readOpChecksumInfo": map[string]interface{}{
+			"checksum":    map[string]interface{}{"type": checksumTypeCRC32C, "bytesPerChecksum": bytesPerChecksum},
+			"chunkOffset": fmt.Sprint(start),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var seqno int64
+	for offset := start; offset < end; seqno++ {
+		n := end - offset
+		if n > writePacketSize {
+			n = writePacketSize
+		}
+
+		err := writeDataPacket(w, packetHeader{OffsetInBlock: int64(offset), Seqno: seqno}, data[offset:offset+n])
+		if err != nil {
+			return err
+		}
+		offset += n
+	}
+
+	return writeDataPacket(w, packetHeader{OffsetInBlock: int64(end), Seqno: seqno, LastPacketInBlock: true}, nil)
+}
+
+func (dn *datanode) writeBlock(r io.Reader, w io.Writer, op *opWriteBlock) error {
+	b := op.Header.BaseHeader.Block
+	if _, ok := dn.data(b); !ok {
+		return writeOpResponse(w, map[string]interface{}{
+			"status":  statusError,
+			"message": fmt.Sprintf("Block %d does not exist", b.BlockID),
+		})
+	}
+
+	// The first bad link is sent for the response to fill the few bytes
+	// the client reads ahead.
+	if err := writeOpResponse(w, map[string]interface{}{"status": statusSuccess, "firstBadLink": ""}); err != nil {
+		return err
+	}
+
+	for {
+		header, checksums, data, err := readDataPacket(r)
+		if err != nil {
+			return err
+		}
+
+		status := statusSuccess
+		if !bytes.Equal(checksums, chunkChecksums(data)) {
+			status = statusErrorChecksum
+		} else if header.Seqno != heartbeatSeqno && !dn.write(b.BlockID, header.OffsetInBlock, data) {
+			status = statusError
+		}
+
+		ack, err := newMessage("hadoop.hdfs.PipelineAckProto", nil)
+		if err != nil {
+			return err
+		}
+		if err = encodeMessage(&pipelineAck{Seqno: header.Seqno, Reply: []int{status}}, ack); err != nil {
+			return err
+		}
+		if err = writePrefixed(w, ack); err != nil {
+			return err
+		}
+
+		if header.LastPacketInBlock || status != statusSuccess {
+			return nil
+		}
+	}
+}
+
+// write stores data in a block at the given offset.
+func (dn *datanode) write(id uint64, offset int64, data []byte) bool {
+	dn.cluster.mu.Lock()
+	defer dn.cluster.mu.Unlock()
+
+	blk, ok := dn.cluster.blocks[id]
+	if !ok || offset > int64(len(blk.data)) {
+		return false
+	}
+
+	blk.data = append(blk.data[:offset], data...)
+	return true
+}
+
+func (dn *datanode) blockChecksum(w io.Writer, op *opBlockChecksum) error {
+	b := op.Header.Block
+	data, ok := dn.data(b)
+	if !ok {
+		return writeOpResponse(w, map[string]interface{}{
+			"status":  statusError,
+			"message": fmt.Sprintf("Replica not found for block %d", b.BlockID),
+		})
+	}
+
+	crcs := chunkChecksums(data)
+	sum := md5.Sum(crcs)
+	return writeOpResponse(w, map[string]interface{}{
+		"status": statusSuccess,
+		"checksumResponse": map[string]interface{}{
+			"bytesPerCrc":   bytesPerChecksum,
+			"crcPerBlock":   fmt.Sprint(len(crcs) / 4),
+			"blockChecksum": sum[:],
+			"crcType":       checksumTypeCRC32C,
+		},
+	})
+}
+
+// chunkChecksums returns the big-endian CRC32C of every chunk of data.
+func chunkChecksums(data []byte) []byte {
+	var crcs []byte
+	for len(data) > 0 {
+		n := bytesPerChecksum
+		if n > len(data) {
+			n = len(data)
+		}
+
+		var crc [4]byte
+		binary.BigEndian.PutUint32(crc[:], crc32.Checksum(data[:n], castagnoli))
+		crcs = append(crcs, crc[:]...)
+		data = data[n:]
+	}
+
+	return crcs
+}
+
+// readOp reads the varint prefixed message of an op.
+func readOp(r *bufio.Reader, name string, v interface{}) error {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, length)
+	if _, err = io.ReadFull(r, data); err != nil {
+		return err
+	}
+
+	m, err := newMessage(name, data)
+	if err != nil {
+		return err
+	}
+
+	return decodeMessage(m, v)
+}
+
+func writeOpResponse(w io.Writer, v interface{}) error {
+	m, err := newMessage("hadoop.hdfs.BlockOpResponseProto", nil)
+	if err != nil {
+		return err
+	}
+	if err = encodeMessage(v, m); err != nil {
+		return err
+	}
+
+	return writePrefixed(w, m)
+}
+
+func writePrefixed(w io.Writer, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(data)))
+	_, err = w.Write(append(prefix[:n], data...))
+	return err
+}
+
+// A packet is made of:
+// +-----------------------------------------------------------+
+// |  uint32 length of the checksums and the data, plus 4      |
+// +-----------------------------------------------------------+
+// |  uint16 length of the header                              |
+// +-----------------------------------------------------------+
+// |  PacketHeaderProto                                        |
+// +-----------------------------------------------------------+
+// |  CRC32C checksums of the chunks                           |
+// +-----------------------------------------------------------+
+// |  data                                                     |
+// +-----------------------------------------------------------+
+func writeDataPacket(w io.Writer, header packetHeader, data []byte) error {
+	header.DataLen = int32(len(data))
+	m, err := newMessage("hadoop.hdfs.PacketHeaderProto", nil)
+	if err != nil {
+		return err
+	}
+	if err = encodeMessage(&header, m); err != nil {
+		return err
+	}
+	headerBytes, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	checksums := chunkChecksums(data)
+	packet := make([]byte, 6, 6+len(headerBytes)+len(checksums)+len(data))
+	binary.BigEndian.PutUint32(packet, uint32(len(checksums)+len(data)+4))
+	binary.BigEndian.PutUint16(packet[4:], uint16(len(headerBytes)))
+	packet = append(packet, headerBytes...)
+	packet = append(packet, checksums...)
+	packet = append(packet, data...)
+
+	_, err = w.Write(packet)
+	return err
+}
+
+func readDataPacket(r io.Reader) (header *packetHeader, checksums, data []byte, err error) {
+	lengths := make([]byte, 6)
+	if _, err = io.ReadFull(r, lengths); err != nil {
+		return
+	}
+
+	headerBytes := make([]byte, binary.BigEndian.Uint16(lengths[4:]))
+	if _, err = io.ReadFull(r, headerBytes); err != nil {
+		return
+	}
+
+	m, err := newMessage("hadoop.hdfs.PacketHeaderProto", headerBytes)
+	if err != nil {
+		return
+	}
+	header = &packetHeader{}
+	if err = decodeMessage(m, header); err != nil {
+		return
+	}
+
+	payloadLen := int(binary.BigEndian.Uint32(lengths)) - 4
+	if payloadLen < int(header.DataLen) || header.DataLen < 0 {
+		return nil, nil, nil, errors.New("invalid packet")
+	}
+	payload := make([]byte, payloadLen)
+	if _, err = io.ReadFull(r, payload); err != nil {
+		return
+	}
+
+	split := payloadLen - int(header.DataLen)
+	return header, payload[:split], payload[split:], nil
+}
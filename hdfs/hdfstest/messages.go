@@ -0,0 +1,145 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfstest
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The protocol messages used by the cluster are mapped to plain Go values
+// through their JSON encoding. The tags are the field names of the protocol
+// definition, and 64-bit integers are encoded as JSON strings.
+
+const (
+	fileTypeDir  = 1
+	fileTypeFile = 2
+
+	checksumTypeCRC32C = 2
+
+	statusSuccess       = 0
+	statusError         = 1
+	statusErrorChecksum = 2
+
+	aclScopeAccess = 0
+	aclTypeUser    = 0
+	aclTypeGroup   = 1
+	aclTypeMask    = 2
+	aclTypeOther   = 3
+)
+
+type fsPermission struct {
+	Perm uint32 `json:"perm"`
+}
+
+type extendedBlock struct {
+	PoolID          string `json:"poolId"`
+	BlockID         uint64 `json:"blockId,string"`
+	GenerationStamp uint64 `json:"generationStamp,string"`
+	NumBytes        uint64 `json:"numBytes,string"`
+}
+
+type datanodeID struct {
+	IPAddr       string `json:"ipAddr"`
+	HostName     string `json:"hostName"`
+	DatanodeUUID string `json:"datanodeUuid"`
+	XferPort     uint32 `json:"xferPort"`
+	InfoPort     uint32 `json:"infoPort"`
+	IpcPort      uint32 `json:"ipcPort"`
+}
+
+type datanodeInfo struct {
+	ID datanodeID `json:"id"`
+}
+
+// token is an empty block token. The bytes fields are base64 encoded.
+type token struct {
+	Identifier string `json:"identifier"`
+	Password   string `json:"password"`
+	Kind       string `json:"kind"`
+	Service    string `json:"service"`
+}
+
+type locatedBlock struct {
+	B          extendedBlock  `json:"b"`
+	Offset     uint64         `json:"offset,string"`
+	Locs       []datanodeInfo `json:"locs"`
+	Corrupt    bool           `json:"corrupt"`
+	BlockToken token          `json:"blockToken"`
+}
+
+type locatedBlocks struct {
+	FileLength          uint64         `json:"fileLength,string"`
+	Blocks              []locatedBlock `json:"blocks"`
+	UnderConstruction   bool           `json:"underConstruction"`
+	LastBlock           *locatedBlock  `json:"lastBlock,omitempty"`
+	IsLastBlockComplete bool           `json:"isLastBlockComplete"`
+}
+
+type fileStatus struct {
+	FileType         int            `json:"fileType"`
+	Path             []byte         `json:"path"`
+	Length           uint64         `json:"length,string"`
+	Permission       fsPermission   `json:"permission"`
+	Owner            string         `json:"owner"`
+	Group            string         `json:"group"`
+	ModificationTime uint64         `json:"modification_time,string"`
+	AccessTime       uint64         `json:"access_time,string"`
+	BlockReplication uint32         `json:"block_replication"`
+	Blocksize        uint64         `json:"blocksize,string"`
+	Locations        *locatedBlocks `json:"locations,omitempty"`
+	FileID           uint64         `json:"fileId,string"`
+	ChildrenNum      int32          `json:"childrenNum"`
+	StoragePolicy    uint32         `json:"storagePolicy"`
+	Flags            uint32         `json:"flags"`
+}
+
+type contentSummary struct {
+	Length         uint64 `json:"length,string"`
+	FileCount      uint64 `json:"fileCount,string"`
+	DirectoryCount uint64 `json:"directoryCount,string"`
+	Quota          uint64 `json:"quota,string"`
+	SpaceConsumed  uint64 `json:"spaceConsumed,string"`
+	SpaceQuota     uint64 `json:"spaceQuota,string"`
+}
+
+type aclEntry struct {
+	Type        int    `json:"type"`
+	Scope       int    `json:"scope"`
+	Permissions uint32 `json:"permissions"`
+	Name        string `json:"name,omitempty"`
+}
+
+type storagePolicy struct {
+	PolicyID       uint32 `json:"policyId"`
+	Name           string `json:"name"`
+	CreationPolicy struct {
+		StorageTypes []int `json:"storageTypes"`
+	} `json:"creationPolicy"`
+}
+
+// decodeMessage maps a protocol message onto v.
+func decodeMessage(m proto.Message, v interface{}) error {
+	data, err := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// encodeMessage maps v onto the protocol message m.
+func encodeMessage(v interface{}, m proto.Message) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return protojson.Unmarshal(data, m)
+}
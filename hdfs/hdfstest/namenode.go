@@ -0,0 +1,414 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package hdfstest provides an in-process HDFS cluster for testing. Its
+// namenode speaks the hadoop RPC protocol on a local port and lets the tests
+// inject failures in its calls, and its datanode speaks the data transfer
+// protocol. A bare Namenode only answers the calls given handlers.
+package hdfstest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	// Registers the hadoop protocol messages.
+	_ "github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	handshakeHeader  = "hrpc"
+	noneAuthProtocol = 0
+	handshakeCallID  = -3
+
+	rpcStatusSuccess = 0
+	rpcStatusError   = 1
+
+	rpcErrorApplication  = 1
+	rpcErrorNoSuchMethod = 2
+
+	noSuchMethodException = "org.apache.hadoop.ipc.RpcNoSuchMethodException"
+)
+
+// Handler answers a namenode call. A nil response is sent as an empty
+// message, and an error which is not an *Error is sent as a
+// java.io.IOException.
+type Handler func(req proto.Message) (proto.Message, error)
+
+// handler is a Handler which also receives the user of the connection.
+type handler func(user string, req proto.Message) (proto.Message, error)
+
+// Error is a java exception returned to the client.
+type Error struct {
+	Exception string
+	Message   string
+}
+
+func (e *Error) Error() string {
+	return e.Exception + ": " + e.Message
+}
+
+// Fault is a failure injected in a namenode call.
+type Fault struct {
+	// Delay is the time to wait before the call is answered or fails.
+	Delay time.Duration
+
+	// Exception, if set, is returned instead of calling the handler.
+	Exception string
+	Message   string
+
+	// Disconnect closes the connection without answering.
+	Disconnect bool
+}
+
+// Namenode is a fake namenode. The calls are answered by the registered
+// handlers, unless a fault is pending for the method.
+type Namenode struct {
+	listener net.Listener
+	done     chan struct{}
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	handlers map[string]handler
+	faults   map[string][]Fault
+	calls    map[string]int
+	conns    map[net.Conn]struct{}
+}
+
+// NewNamenode starts a fake namenode listening on a local port.
+func NewNamenode() (*Namenode, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	nn := &Namenode{
+		listener: l,
+		done:     make(chan struct{}),
+		handlers: make(map[string]handler),
+		faults:   make(map[string][]Fault),
+		calls:    make(map[string]int),
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	nn.wg.Add(1)
+	go nn.serve()
+
+	return nn, nil
+}
+
+// Addr returns the address of the namenode.
+func (nn *Namenode) Addr() string {
+	return nn.listener.Addr().String()
+}
+
+// Handle registers the handler of the named method, for example
+// "getFileInfo".
+func (nn *Namenode) Handle(method string, h Handler) {
+	nn.handle(method, func(user string, req proto.Message) (proto.Message, error) {
+		return h(req)
+	})
+}
+
+func (nn *Namenode) handle(method string, h handler) {
+	nn.mu.Lock()
+	defer nn.mu.Unlock()
+
+	nn.handlers[method] = h
+}
+
+// InjectFault queues faults for the named method. Each call consumes one
+// fault. The faults of the empty method apply to every method.
+func (nn *Namenode) InjectFault(method string, faults ...Fault) {
+	nn.mu.Lock()
+	defer nn.mu.Unlock()
+
+	nn.faults[method] = append(nn.faults[method], faults...)
+}
+
+// Calls returns how many times the named method was called.
+func (nn *Namenode) Calls(method string) int {
+	nn.mu.Lock()
+	defer nn.mu.Unlock()
+
+	return nn.calls[method]
+}
+
+// Close stops the namenode and closes all its connections.
+func (nn *Namenode) Close() error {
+	close(nn.done)
+	err := nn.listener.Close()
+
+	nn.mu.Lock()
+	for conn := range nn.conns {
+		conn.Close()
+	}
+	nn.mu.Unlock()
+
+	nn.wg.Wait()
+	return err
+}
+
+func (nn *Namenode) serve() {
+	defer nn.wg.Done()
+
+	for {
+		conn, err := nn.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		nn.mu.Lock()
+		nn.conns[conn] = struct{}{}
+		nn.mu.Unlock()
+
+		nn.wg.Add(1)
+		go func() {
+			defer nn.wg.Done()
+			nn.serveConn(conn)
+
+			nn.mu.Lock()
+			delete(nn.conns, conn)
+			nn.mu.Unlock()
+			conn.Close()
+		}()
+	}
+}
+
+func (nn *Namenode) serveConn(conn net.Conn) {
+	r := bufio.NewReader(conn)
+
+	header := make([]byte, 7)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return
+	}
+	if string(header[:4]) != handshakeHeader || header[6] != noneAuthProtocol {
+		return
+	}
+
+	var user string
+	for {
+		parts, err := readPacket(r)
+		if err != nil || len(parts) == 0 {
+			return
+		}
+
+		rrh, err := newMessage("hadoop.common.RpcRequestHeaderProto", parts[0])
+		if err != nil {
+			return
+		}
+		callID := int32(field(rrh, "callId").Int())
+
+		// The connection context and the pings are not answered.
+		if callID == handshakeCallID && len(parts) == 2 {
+			user = contextUser(parts[1])
+		}
+		if callID < 0 {
+			continue
+		}
+		if len(parts) != 3 {
+			return
+		}
+
+		rh, err := newMessage("hadoop.common.RequestHeaderProto", parts[1])
+		if err != nil {
+			return
+		}
+		method := field(rh, "methodName").String()
+
+		resp, err := nn.call(user, method, parts[2])
+		if err == errDisconnect {
+			return
+		}
+
+		if err = writeResponse(conn, callID, resp, err); err != nil {
+			return
+		}
+	}
+}
+
+var errDisconnect = errors.New("disconnect")
+
+func (nn *Namenode) call(user, method string, data []byte) (proto.Message, error) {
+	nn.mu.Lock()
+	nn.calls[method]++
+	fault, faulty := nn.nextFault(method)
+	h := nn.handlers[method]
+	nn.mu.Unlock()
+
+	if faulty {
+		select {
+		case <-time.After(fault.Delay):
+		case <-nn.done:
+			return nil, errDisconnect
+		}
+
+		if fault.Disconnect {
+			return nil, errDisconnect
+		}
+		if fault.Exception != "" {
+			return nil, &Error{fault.Exception, fault.Message}
+		}
+	}
+
+	if h == nil {
+		return nil, &Error{noSuchMethodException, "Unknown method " + method}
+	}
+
+	req, err := newMessage(messageName(method, "RequestProto"), data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h(user, req)
+	if err != nil || resp != nil {
+		return resp, err
+	}
+
+	return newMessage(messageName(method, "ResponseProto"), nil)
+}
+
+func (nn *Namenode) nextFault(method string) (Fault, bool) {
+	for _, m := range []string{method, ""} {
+		if faults := nn.faults[m]; len(faults) != 0 {
+			nn.faults[m] = faults[1:]
+			return faults[0], true
+		}
+	}
+
+	return Fault{}, false
+}
+
+// readPacket reads a packet made of varint prefixed messages.
+func readPacket(r io.Reader) ([][]byte, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+
+	packet := make([]byte, length)
+	if _, err := io.ReadFull(r, packet); err != nil {
+		return nil, err
+	}
+
+	var parts [][]byte
+	for len(packet) > 0 {
+		n, k := binary.Uvarint(packet)
+		if k <= 0 || n > uint64(len(packet)-k) {
+			return nil, errors.New("invalid packet")
+		}
+
+		parts = append(parts, packet[k:k+int(n)])
+		packet = packet[k+int(n):]
+	}
+
+	return parts, nil
+}
+
+func writeResponse(w io.Writer, callID int32, resp proto.Message, err error) error {
+	rrh, _ := newMessage("hadoop.common.RpcResponseHeaderProto", nil)
+	setField(rrh, "callId", protoreflect.ValueOfUint32(uint32(callID)))
+
+	msgs := []proto.Message{rrh}
+	if err == nil {
+		setField(rrh, "status", protoreflect.ValueOfEnum(rpcStatusSuccess))
+		msgs = append(msgs, resp)
+	} else {
+		exception, message := "java.io.IOException", err.Error()
+		code := protoreflect.EnumNumber(rpcErrorApplication)
+
+		var remoteErr *Error
+		if errors.As(err, &remoteErr) {
+			exception, message = remoteErr.Exception, remoteErr.Message
+		}
+		if exception == noSuchMethodException {
+			code = rpcErrorNoSuchMethod
+		}
+
+		setField(rrh, "status", protoreflect.ValueOfEnum(rpcStatusError))
+		setField(rrh, "exceptionClassName", protoreflect.ValueOfString(exception))
+		setField(rrh, "errorMsg", protoreflect.ValueOfString(message))
+		setField(rrh, "errorDetail", protoreflect.ValueOfEnum(code))
+	}
+
+	var body bytes.Buffer
+	for _, m := range msgs {
+		data, err := proto.Marshal(m)
+		if err != nil {
+			return err
+		}
+
+		var prefix [binary.MaxVarintLen64]byte
+		body.Write(prefix[:binary.PutUvarint(prefix[:], uint64(len(data)))])
+		body.Write(data)
+	}
+
+	packet := make([]byte, 4, 4+body.Len())
+	binary.BigEndian.PutUint32(packet, uint32(body.Len()))
+	_, err = w.Write(append(packet, body.Bytes()...))
+	return err
+}
+
+// contextUser returns the effective user of an IpcConnectionContextProto.
+func contextUser(data []byte) string {
+	cc, err := newMessage("hadoop.common.IpcConnectionContextProto", data)
+	if err != nil {
+		return ""
+	}
+
+	return field(field(cc, "userInfo").Message().Interface(), "effectiveUser").String()
+}
+
+// The request messages which are not named after their method.
+var requestNames = map[string]string{
+	"getFsStats": "hadoop.hdfs.GetFsStatusRequestProto",
+}
+
+func messageName(method, suffix string) string {
+	if name, ok := requestNames[method]; ok && suffix == "RequestProto" {
+		return name
+	}
+
+	return "hadoop.hdfs." + strings.ToUpper(method[:1]) + method[1:] + suffix
+}
+
+// newMessage returns a new message of the named type, decoded from data.
+func newMessage(name string, data []byte) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("unknown message %s: %v", name, err)
+	}
+
+	m := mt.New().Interface()
+	if data == nil {
+		return m, nil
+	}
+	if err = proto.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func field(m proto.Message, name string) protoreflect.Value {
+	r := m.ProtoReflect()
+	return r.Get(r.Descriptor().Fields().ByName(protoreflect.Name(name)))
+}
+
+func setField(m proto.Message, name string, v protoreflect.Value) {
+	r := m.ProtoReflect()
+	r.Set(r.Descriptor().Fields().ByName(protoreflect.Name(name)), v)
+}
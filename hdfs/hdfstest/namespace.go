@@ -0,0 +1,275 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfstest
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	fileNotFoundException          = "java.io.FileNotFoundException"
+	ioException                    = "java.io.IOException"
+	fileAlreadyExistsException     = "org.apache.hadoop.fs.FileAlreadyExistsException"
+	parentNotDirectoryException    = "org.apache.hadoop.fs.ParentNotDirectoryException"
+	pathIsNotEmptyDirException     = "org.apache.hadoop.fs.PathIsNotEmptyDirectoryException"
+	illegalArgumentException       = "org.apache.hadoop.HadoopIllegalArgumentException"
+	alreadyBeingCreatedException   = "org.apache.hadoop.hdfs.protocol.AlreadyBeingCreatedException"
+	nsQuotaExceededException       = "org.apache.hadoop.hdfs.protocol.NSQuotaExceededException"
+	dsQuotaExceededException       = "org.apache.hadoop.hdfs.protocol.DSQuotaExceededException"
+	snapshotException              = "org.apache.hadoop.hdfs.protocol.SnapshotException"
+	snapshotAccessControlException = "org.apache.hadoop.hdfs.protocol.SnapshotAccessControlException"
+	leaseExpiredException          = "org.apache.hadoop.hdfs.server.namenode.LeaseExpiredException"
+	snapshotDirName                = ".snapshot"
+	noQuota                        = -1
+)
+
+// inode is a file or a directory of the namespace.
+type inode struct {
+	name  string
+	dir   bool
+	perm  uint32
+	owner string
+	group string
+	mtime uint64
+	atime uint64
+	acl   []aclEntry
+
+	// storagePolicy is zero when the policy is inherited.
+	storagePolicy uint32
+
+	// Files
+	id          uint64
+	replication uint32
+	blockSize   uint64
+	blocks      []*block
+	client      string // holds the lease while the file is written
+
+	// Directories
+	children      map[string]*inode
+	nsQuota       int64
+	ssQuota       int64
+	snapshottable bool
+	snapshots     []*inode // the copies of the directory, named after the snapshots
+}
+
+// block is a block of a file. Its data is kept by the datanode.
+type block struct {
+	id       uint64
+	genStamp uint64
+	data     []byte
+}
+
+func newDir(name string, perm uint32, owner, group string, now uint64) *inode {
+	return &inode{
+		name:     name,
+		dir:      true,
+		perm:     perm,
+		owner:    owner,
+		group:    group,
+		mtime:    now,
+		atime:    now,
+		children: make(map[string]*inode),
+		nsQuota:  noQuota,
+		ssQuota:  noQuota,
+	}
+}
+
+func (n *inode) length() uint64 {
+	var l uint64
+	for _, b := range n.blocks {
+		l += uint64(len(b.data))
+	}
+
+	return l
+}
+
+func (n *inode) sortedChildren() []*inode {
+	children := make([]*inode, 0, len(n.children))
+	for _, c := range n.children {
+		children = append(children, c)
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].name < children[j].name })
+
+	return children
+}
+
+// usage returns the number of inodes and the space consumed by the tree.
+func (n *inode) usage() (count int64, space int64) {
+	if !n.dir {
+		return 1, int64(n.length()) * int64(n.replication)
+	}
+
+	count = 1
+	for _, c := range n.children {
+		cc, cs := c.usage()
+		count += cc
+		space += cs
+	}
+
+	return count, space
+}
+
+// lookup is the result of a path resolution.
+type lookup struct {
+	// path is the cleaned path.
+	path string
+
+	// parents holds the directories from the root to the parent of node.
+	parents []*inode
+
+	// node is nil if the path does not exist.
+	node *inode
+
+	// snapshot is set if the path is inside a read-only snapshot.
+	snapshot bool
+}
+
+func (l *lookup) parent() *inode {
+	if len(l.parents) == 0 {
+		return nil
+	}
+
+	return l.parents[len(l.parents)-1]
+}
+
+func (l *lookup) name() string {
+	return path.Base(l.path)
+}
+
+// resolve walks the namespace to the named path. The .snapshot directory of a
+// snapshottable directory lists its snapshots.
+func (c *Cluster) resolve(p string) (*lookup, error) {
+	if !path.IsAbs(p) {
+		return nil, &Error{illegalArgumentException, "Path is not absolute: " + p}
+	}
+
+	l := &lookup{path: path.Clean(p), node: c.root}
+	if l.path == "/" {
+		return l, nil
+	}
+
+	for _, name := range strings.Split(l.path[1:], "/") {
+		cur := l.node
+		if cur == nil {
+			return nil, &Error{fileNotFoundException, "Parent path does not exist: " + l.path}
+		}
+		if !cur.dir {
+			return nil, &Error{parentNotDirectoryException, "Parent path is not a directory: " + cur.name}
+		}
+
+		l.parents = append(l.parents, cur)
+		if name == snapshotDirName && cur.snapshottable && !l.snapshot {
+			l.node = snapshotsDir(cur)
+			l.snapshot = true
+			continue
+		}
+
+		l.node = cur.children[name]
+	}
+
+	return l, nil
+}
+
+// snapshotsDir returns a read-only directory holding the snapshots of dir.
+func snapshotsDir(dir *inode) *inode {
+	n := newDir(snapshotDirName, dir.perm, dir.owner, dir.group, dir.mtime)
+	for _, s := range dir.snapshots {
+		n.children[s.name] = s
+	}
+
+	return n
+}
+
+// resolveExisting resolves a path which must exist.
+func (c *Cluster) resolveExisting(p string) (*lookup, error) {
+	l, err := c.resolve(p)
+	if err != nil {
+		return nil, err
+	}
+	if l.node == nil {
+		return nil, &Error{fileNotFoundException, "File does not exist: " + l.path}
+	}
+
+	return l, nil
+}
+
+// resolveWritable resolves a path which is modified.
+func (c *Cluster) resolveWritable(p string) (*lookup, error) {
+	l, err := c.resolve(p)
+	if err != nil {
+		return nil, err
+	}
+	if l.snapshot {
+		return nil, &Error{snapshotAccessControlException, "Modification on a read-only snapshot is disallowed"}
+	}
+
+	return l, nil
+}
+
+// checkQuota checks that the ancestors can hold count more inodes and space
+// more bytes.
+func checkQuota(parents []*inode, count, space int64) error {
+	for i, dir := range parents {
+		if dir.nsQuota == noQuota && dir.ssQuota == noQuota {
+			continue
+		}
+
+		used, consumed := dir.usage()
+		name := "/" + pathOf(parents[1:i+1])
+		if dir.nsQuota != noQuota && count > 0 && used+count > dir.nsQuota {
+			return &Error{nsQuotaExceededException, fmt.Sprintf(
+				"The NameSpace quota (directories and files) of directory %s is exceeded: quota=%d file count=%d",
+				name, dir.nsQuota, used+count)}
+		}
+		if dir.ssQuota != noQuota && space > 0 && consumed+space > dir.ssQuota {
+			return &Error{dsQuotaExceededException, fmt.Sprintf(
+				"The DiskSpace quota of %s is exceeded: quota = %d B but diskspace consumed = %d B",
+				name, dir.ssQuota, consumed+space)}
+		}
+	}
+
+	return nil
+}
+
+func pathOf(dirs []*inode) string {
+	names := make([]string, len(dirs))
+	for i, d := range dirs {
+		names[i] = d.name
+	}
+
+	return strings.Join(names, "/")
+}
+
+// copyTree returns a copy of the tree, used as a snapshot. The blocks are
+// copied as well, since the files may be appended to.
+func (c *Cluster) copyTree(n *inode, name string) *inode {
+	cp := *n
+	cp.name = name
+	cp.client = ""
+	cp.snapshottable = false
+	cp.snapshots = nil
+	cp.acl = append([]aclEntry(nil), n.acl...)
+
+	if n.dir {
+		cp.children = make(map[string]*inode, len(n.children))
+		for childName, child := range n.children {
+			cp.children[childName] = c.copyTree(child, childName)
+		}
+		return &cp
+	}
+
+	cp.blocks = make([]*block, len(n.blocks))
+	for i, b := range n.blocks {
+		cp.blocks[i] = c.newBlock()
+		cp.blocks[i].data = append([]byte(nil), b.data...)
+	}
+
+	return &cp
+}
@@ -0,0 +1,120 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	krb "github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+)
+
+const defaultKrb5Config = "/etc/krb5.conf"
+
+// configureKerberos sets up the kerberos client of options. Kerberos is used
+// when a keytab or a credential cache is configured, or when the hadoop
+// configuration requires it, in which case the default credential cache is
+// used.
+func configureKerberos(cfg *extfs.Config, options *hdfs.ClientOptions) error {
+	if cfg.KerberosServicePrincipalName != "" {
+		options.KerberosServicePrincipleName = strings.Split(cfg.KerberosServicePrincipalName, "@")[0]
+	}
+
+	if cfg.KerberosKeytab == "" && cfg.KerberosCCache == "" && options.KerberosClient == nil {
+		return nil
+	}
+
+	client, err := newKerberosClient(cfg)
+	if err != nil {
+		return err
+	}
+	if options.KerberosServicePrincipleName == "" {
+		return errors.New("Kerberos service principal name of the namenode is not set")
+	}
+
+	options.KerberosClient = client
+	return nil
+}
+
+func newKerberosClient(cfg *extfs.Config) (*krb.Client, error) {
+	krb5conf, err := loadKrb5Config(cfg.KerberosConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.KerberosKeytab == "" {
+		ccache, err := credentials.LoadCCache(ccachePath(cfg.KerberosCCache))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load kerberos credential cache: %v", err)
+		}
+		return krb.NewFromCCache(ccache, krb5conf, krb.DisablePAFXFAST(true))
+	}
+
+	kt, err := keytab.Load(cfg.KerberosKeytab)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load kerberos keytab: %v", err)
+	}
+
+	username, realm := splitPrincipal(cfg.KerberosPrincipal)
+	if username == "" {
+		if len(kt.Entries) == 0 {
+			return nil, errors.New("Kerberos keytab has no entries")
+		}
+		p := kt.Entries[0].Principal
+		username, realm = strings.Join(p.Components, "/"), p.Realm
+	}
+	if realm == "" {
+		realm = krb5conf.LibDefaults.DefaultRealm
+	}
+
+	return krb.NewWithKeytab(username, realm, kt, krb5conf, krb.DisablePAFXFAST(true)), nil
+}
+
+func loadKrb5Config(path string) (*config.Config, error) {
+	if path == "" {
+		path = os.Getenv("KRB5_CONFIG")
+	}
+	if path == "" {
+		path = defaultKrb5Config
+	}
+
+	c, err := config.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load kerberos configuration %s: %v", path, err)
+	}
+
+	return c, nil
+}
+
+// ccachePath returns the credential cache to use, following the same
+// defaults as kinit.
+func ccachePath(path string) string {
+	if path == "" {
+		path = os.Getenv("KRB5CCNAME")
+	}
+	if path == "" {
+		return fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
+	}
+
+	return strings.TrimPrefix(path, "FILE:")
+}
+
+func splitPrincipal(principal string) (string, string) {
+	i := strings.LastIndex(principal, "@")
+	if i < 0 {
+		return principal, ""
+	}
+
+	return principal[:i], principal[i+1:]
+}
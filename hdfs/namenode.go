@@ -0,0 +1,137 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	fileNotFoundException      = "java.io.FileNotFoundException"
+	permissionDeniedException  = "org.apache.hadoop.security.AccessControlException"
+	pathIsNotEmptyDirException = "org.apache.hadoop.fs.PathIsNotEmptyDirectoryException"
+	fileAlreadyExistsException = "org.apache.hadoop.fs.FileAlreadyExistsException"
+	illegalArgumentException   = "org.apache.hadoop.HadoopIllegalArgumentException"
+	unsupportedException       = "java.lang.UnsupportedOperationException"
+)
+
+// namenode gives access to the ClientNamenodeProtocol RPCs which are not
+// wrapped by the client library.
+//
+// The client library registers every protocol message in the global protobuf
+// registry, and its fork in third_party sends any RPC on the connection of
+// the client. namenode uses that connection, so all the RPCs share the
+// client's failover and authentication, and it translates plain Go values to
+// and from the protocol messages through their JSON mapping.
+type namenode struct {
+	client *hdfs.Client
+	retry  *retryPolicy
+}
+
+func newNamenode(client *hdfs.Client, retry *retryPolicy) *namenode {
+	return &namenode{client: client, retry: retry}
+}
+
+// call performs the named RPC. req and resp are values which encoding/json
+// maps onto the request and response messages, using the field names from
+// the protocol definition.
+func (nn *namenode) call(method string, req, resp interface{}) error {
+	reqMsg, err := newMessage(method, "RequestProto")
+	if err != nil {
+		return err
+	}
+	respMsg, err := newMessage(method, "ResponseProto")
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if err = protojson.Unmarshal(data, reqMsg); err != nil {
+		return err
+	}
+
+	// Only the getters are safe to repeat.
+	do := nn.retry.doOnce
+	if strings.HasPrefix(method, "get") {
+		do = nn.retry.do
+	}
+
+	err = do(func() error {
+		return nn.client.Execute(method, reqMsg, respMsg)
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp == nil {
+		return nil
+	}
+
+	return decodeMessage(respMsg, resp)
+}
+
+// decodeMessage maps a protocol message onto v through encoding/json. 64-bit
+// integers are encoded as JSON strings.
+func decodeMessage(m proto.Message, v interface{}) error {
+	data, err := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func newMessage(method, suffix string) (proto.Message, error) {
+	name := protoreflect.FullName("hadoop.hdfs." + strings.ToUpper(method[:1]) + method[1:] + suffix)
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("Unknown namenode message %s: %v", name, err)
+	}
+
+	return mt.New().Interface(), nil
+}
+
+// interpretException converts the well-known java exceptions returned by the
+// namenode to the matching os errors.
+func interpretException(err error) error {
+	var remoteErr hdfs.Error
+	if !errors.As(err, &remoteErr) {
+		return err
+	}
+
+	switch remoteErr.Exception() {
+	case fileNotFoundException:
+		return os.ErrNotExist
+	case permissionDeniedException:
+		return os.ErrPermission
+	case pathIsNotEmptyDirException:
+		return syscall.ENOTEMPTY
+	case fileAlreadyExistsException:
+		return os.ErrExist
+	case illegalArgumentException:
+		return os.ErrInvalid
+	case unsupportedException:
+		return extfs.ErrUnsupported
+	default:
+		return err
+	}
+}
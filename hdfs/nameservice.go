@@ -0,0 +1,90 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs/hadoopconf"
+)
+
+// resolveNamenodes returns the namenodes to connect to. Addresses which are
+// logical nameservice IDs, such as the authority of hdfs://mycluster/, are
+// replaced by the rpc addresses of the nameservice namenodes, between which
+// the client fails over. If a nameservice is given, the namenodes of the
+// other clusters found in the configuration are not used. An address without
+// a port which is not a nameservice of the configuration is an error.
+func resolveNamenodes(conf hadoopconf.HadoopConf, defaults, addrs []string) ([]string, error) {
+	if len(addrs) == 0 {
+		if u, err := url.Parse(conf["fs.defaultFS"]); err == nil && isNameservice(conf, u.Host) {
+			addrs = []string{u.Host}
+		}
+	}
+
+	var resolved []string
+	nameservice := false
+	for _, addr := range addrs {
+		if !isNameservice(conf, addr) {
+			if !strings.Contains(addr, ":") {
+				return nil, fmt.Errorf("Unknown nameservice %s", addr)
+			}
+			resolved = append(resolved, addr)
+			continue
+		}
+
+		nns, err := nameserviceNamenodes(conf, addr)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, nns...)
+		nameservice = true
+	}
+
+	if nameservice {
+		return resolved, nil
+	}
+
+	return append(defaults, resolved...), nil
+}
+
+func isNameservice(conf hadoopconf.HadoopConf, addr string) bool {
+	if addr == "" || strings.Contains(addr, ":") {
+		return false
+	}
+
+	_, ha := conf["dfs.ha.namenodes."+addr]
+	_, single := conf["dfs.namenode.rpc-address."+addr]
+	return ha || single
+}
+
+func nameserviceNamenodes(conf hadoopconf.HadoopConf, nameservice string) ([]string, error) {
+	ids, ok := conf["dfs.ha.namenodes."+nameservice]
+	if !ok {
+		return []string{conf["dfs.namenode.rpc-address."+nameservice]}, nil
+	}
+
+	var nns []string
+	for _, id := range strings.Split(ids, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+
+		addr, ok := conf["dfs.namenode.rpc-address."+nameservice+"."+id]
+		if !ok {
+			return nil, fmt.Errorf("Missing dfs.namenode.rpc-address.%s.%s for nameservice %s", nameservice, id, nameservice)
+		}
+		nns = append(nns, addr)
+	}
+	if len(nns) == 0 {
+		return nil, fmt.Errorf("No namenodes configured for nameservice %s", nameservice)
+	}
+
+	return nns, nil
+}
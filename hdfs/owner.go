@@ -0,0 +1,38 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+func (fs *hadoop) Owner(name string) (string, string, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return "", "", err
+	}
+	hfi := fi.(*hdfs.FileInfo)
+
+	return hfi.Owner(), hfi.OwnerGroup(), nil
+}
+
+func (fs *hadoop) Chown(name, user, group string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	return fs.retry.doOnce(func() error {
+		return fs.client.Chown(fullpath, user, group)
+	})
+}
@@ -0,0 +1,45 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+)
+
+// configureProtection applies the protection levels of cfg to options.
+func configureProtection(cfg *extfs.Config, options *hdfs.ClientOptions) error {
+	if cfg.DataTransferProtection != "" {
+		protection, err := parseProtection(cfg.DataTransferProtection)
+		if err != nil {
+			return err
+		}
+		options.DataTransferProtection = protection
+	}
+
+	if cfg.RPCProtection != "" {
+		protection, err := parseProtection(cfg.RPCProtection)
+		if err != nil {
+			return err
+		}
+		options.RPCProtection = protection
+	}
+
+	return nil
+}
+
+func parseProtection(protection string) (string, error) {
+	switch p := strings.ToLower(protection); p {
+	case extfs.ProtectionAuthentication, extfs.ProtectionIntegrity, extfs.ProtectionPrivacy:
+		return p, nil
+	default:
+		return "", fmt.Errorf("Invalid protection level %s", protection)
+	}
+}
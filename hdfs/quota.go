@@ -0,0 +1,159 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"errors"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Special quota values accepted by SetQuota.
+const (
+	// QuotaDontSet leaves the current quota unchanged.
+	QuotaDontSet = math.MaxInt64
+	// QuotaReset removes the quota.
+	QuotaReset = -1
+)
+
+const (
+	nsQuotaExceededException = "org.apache.hadoop.hdfs.protocol.NSQuotaExceededException"
+	dsQuotaExceededException = "org.apache.hadoop.hdfs.protocol.DSQuotaExceededException"
+)
+
+// Quota describes the quotas of a directory and how much of them is used.
+// A quota of -1 means that no quota is set.
+type Quota struct {
+	// NamespaceQuota limits the number of files and directories in the tree.
+	NamespaceQuota int64
+	NamespaceUsed  int64
+
+	// SpaceQuota limits the bytes used by the tree, including the replicas.
+	SpaceQuota int64
+	SpaceUsed  int64
+}
+
+// QuotaManager is implemented by the hadoop filesystem to manage the
+// namespace and space quotas of the directories.
+type QuotaManager interface {
+	// GetQuota returns the quotas of the named directory.
+	GetQuota(name string) (*Quota, error)
+
+	// SetQuota sets the namespace and space quotas of the named directory.
+	// Use QuotaDontSet to keep one of them and QuotaReset to remove it.
+	// Setting quotas requires superuser privileges.
+	SetQuota(name string, namespaceQuota, spaceQuota int64) error
+}
+
+// QuotaExceededError is returned when a write or a create would exceed the
+// quota of one of the parent directories.
+type QuotaExceededError struct {
+	// Op and Path are the operation and the path of the failed call, empty
+	// if the namenode error was not returned as an *os.PathError.
+	Op   string
+	Path string
+
+	// Space tells whether the space quota (DSQuotaExceededException) or the
+	// namespace quota (NSQuotaExceededException) is exceeded.
+	Space bool
+
+	// Message is the message of the namenode.
+	Message string
+
+	// Err is the error of the namenode.
+	Err error
+}
+
+func (e *QuotaExceededError) Error() string {
+	if e.Op == "" {
+		return e.Message
+	}
+
+	return e.Op + " " + e.Path + ": " + e.Message
+}
+
+func (e *QuotaExceededError) Unwrap() error {
+	return e.Err
+}
+
+type setQuotaRequest struct {
+	Path              string `json:"path"`
+	NamespaceQuota    uint64 `json:"namespaceQuota"`
+	StoragespaceQuota uint64 `json:"storagespaceQuota"`
+}
+
+func (fs *hadoop) GetQuota(name string) (*Quota, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := fs.contentSummary(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quota{
+		NamespaceQuota: int64(cs.NameQuota()),
+		NamespaceUsed:  int64(cs.FileCount() + cs.DirectoryCount()),
+		SpaceQuota:     cs.SpaceQuota(),
+		SpaceUsed:      cs.SizeAfterReplication(),
+	}, nil
+}
+
+func (fs *hadoop) SetQuota(name string, namespaceQuota, spaceQuota int64) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	req := &setQuotaRequest{
+		Path:              fullpath,
+		NamespaceQuota:    uint64(namespaceQuota),
+		StoragespaceQuota: uint64(spaceQuota),
+	}
+	err = fs.namenode.call("setQuota", req, nil)
+	if err != nil {
+		return &os.PathError{Op: "setquota", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
+
+// interpretQuotaException converts the quota exceptions returned by the
+// namenode to a QuotaExceededError, other errors are returned unchanged.
+func interpretQuotaException(err error) error {
+	var remoteErr hdfs.Error
+	if !errors.As(err, &remoteErr) {
+		return err
+	}
+
+	exception := remoteErr.Exception()
+	if exception != nsQuotaExceededException && exception != dsQuotaExceededException {
+		return err
+	}
+
+	msg := strings.SplitN(remoteErr.Message(), "\n", 2)[0]
+	if msg == "" {
+		msg = exception
+	}
+
+	qerr := &QuotaExceededError{
+		Space:   exception == dsQuotaExceededException,
+		Message: msg,
+		Err:     err,
+	}
+	if perr, ok := err.(*os.PathError); ok {
+		qerr.Op, qerr.Path, qerr.Err = perr.Op, perr.Path, perr.Err
+	}
+
+	return qerr
+}
@@ -0,0 +1,258 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+)
+
+const (
+	defaultRetryBackoff = time.Second
+	maxRetryBackoff     = time.Minute
+	namenodeBackoff     = 5 * time.Second
+
+	retriableException = "org.apache.hadoop.ipc.RetriableException"
+	standbyException   = "org.apache.hadoop.ipc.StandbyException"
+	safeModeException  = "org.apache.hadoop.hdfs.server.namenode.SafeModeException"
+)
+
+// retryPolicy retries the failed operations with an exponential backoff.
+type retryPolicy struct {
+	maxRetries int
+	backoff    time.Duration
+	retryable  func(err error) bool
+	dialer     *namenodeDialer
+}
+
+func newRetryPolicy(cfg *extfs.Config, dialer *namenodeDialer) *retryPolicy {
+	p := &retryPolicy{
+		maxRetries: cfg.MaxRetries,
+		backoff:    cfg.RetryBackoff,
+		retryable:  cfg.Retryable,
+		dialer:     dialer,
+	}
+	if p.backoff <= 0 {
+		p.backoff = defaultRetryBackoff
+	}
+	if p.retryable == nil {
+		p.retryable = IsRetryable
+	}
+
+	return p
+}
+
+// do runs op, which is safe to repeat, until it succeeds, fails with an
+// error which is not retryable or the retries are exhausted.
+func (p *retryPolicy) do(op func() error) error {
+	return p.run(true, op)
+}
+
+// doOnce runs op, which must not be applied twice, like do. The namenode may
+// have applied a request whose response was lost, so op is only retried when
+// none of its requests reached a namenode, or when the namenode refused it.
+func (p *retryPolicy) doOnce(op func() error) error {
+	return p.run(false, op)
+}
+
+func (p *retryPolicy) run(idempotent bool, op func() error) error {
+	backoff := p.backoff
+	for attempt := 0; ; attempt++ {
+		writes := p.dialer.written()
+		err := op()
+		if err == nil {
+			return nil
+		}
+
+		sent := p.dialer.written() != writes
+		if !sent && !isRemote(err) && p.dialer.unavailable() {
+			err = &unavailableError{err}
+		}
+		if attempt >= p.maxRetries || !p.retryable(err) || !(idempotent || !sent || isRefused(err)) {
+			return err
+		}
+
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// unavailableError is returned when none of the namenodes can be reached.
+type unavailableError struct {
+	err error
+}
+
+func (e *unavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *unavailableError) Unwrap() error {
+	return e.err
+}
+
+// isRemote reports whether err was returned by a namenode.
+func isRemote(err error) bool {
+	var remoteErr hdfs.Error
+	return errors.As(err, &remoteErr)
+}
+
+// isRefused reports whether a namenode refused the request without applying
+// it.
+func isRefused(err error) bool {
+	var remoteErr hdfs.Error
+	if !errors.As(err, &remoteErr) {
+		return false
+	}
+
+	switch remoteErr.Exception() {
+	case retriableException, standbyException, safeModeException:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRetryable reports whether err is a transient failure: a network error,
+// or a namenode which is in standby, in safe mode or asks to retry.
+//
+// The client skips a namenode for five seconds after a connection failure,
+// so a cluster with a single namenode is only retried after that delay.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if isRemote(err) {
+		return isRefused(err)
+	}
+
+	var unavailable *unavailableError
+	if errors.As(err, &unavailable) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	for _, e := range []error{io.EOF, io.ErrUnexpectedEOF, syscall.ECONNREFUSED, syscall.ECONNRESET, syscall.EPIPE} {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configureDialers sets the dial functions of options which apply the
+// timeouts of cfg, and returns the namenode dialer.
+func configureDialers(cfg *extfs.Config, options *hdfs.ClientOptions) *namenodeDialer {
+	dialer := &namenodeDialer{
+		dialer:  &net.Dialer{Timeout: cfg.DialTimeout},
+		timeout: cfg.RPCTimeout,
+	}
+	if cfg.DialTimeout > 0 {
+		options.DatanodeDialFunc = dialer.dialer.DialContext
+	}
+	options.NamenodeDialFunc = dialer.DialContext
+
+	return dialer
+}
+
+// namenodeDialer dials the namenodes. It counts the requests written to the
+// namenodes, which tells the retry policy whether a failed operation reached
+// a namenode, and records the last connection failure. The count is shared
+// by the operations running concurrently, which only makes the policy retry
+// less.
+type namenodeDialer struct {
+	writes   uint64
+	failedAt int64
+
+	dialer  *net.Dialer
+	timeout time.Duration
+}
+
+func (d *namenodeDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	conn, err := d.dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		d.fail()
+		return nil, err
+	}
+
+	return &namenodeConn{conn, d}, nil
+}
+
+// written returns the number of writes to the namenodes so far.
+func (d *namenodeDialer) written() uint64 {
+	return atomic.LoadUint64(&d.writes)
+}
+
+func (d *namenodeDialer) fail() {
+	atomic.StoreInt64(&d.failedAt, time.Now().UnixNano())
+}
+
+// unavailable reports whether a connection failed recently. The client skips
+// a namenode for five seconds after a failure, and reports that none of them
+// are available with a plain error.
+func (d *namenodeDialer) unavailable() bool {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&d.failedAt))) < namenodeBackoff
+}
+
+// namenodeConn is a namenode connection which fails the calls that are not
+// answered within the timeout of the dialer, if any. Each request pushes the
+// deadline back. After a failed read the connection is closed, since a late
+// response would otherwise be read as the response of the next call, and
+// the next request would be written to a broken connection.
+type namenodeConn struct {
+	net.Conn
+	dialer *namenodeDialer
+}
+
+func (c *namenodeConn) Write(b []byte) (int, error) {
+	if c.dialer.timeout > 0 {
+		if err := c.Conn.SetDeadline(time.Now().Add(c.dialer.timeout)); err != nil {
+			c.dialer.fail()
+			return 0, err
+		}
+	}
+
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		atomic.AddUint64(&c.dialer.writes, 1)
+	}
+	if err != nil {
+		c.dialer.fail()
+	}
+
+	return n, err
+}
+
+func (c *namenodeConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if err != nil {
+		c.dialer.fail()
+		c.Conn.Close()
+	}
+
+	return n, err
+}
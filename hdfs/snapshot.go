@@ -0,0 +1,223 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+const snapshotDir = ".snapshot"
+
+// Snapshotter is implemented by the hadoop filesystem to manage the
+// snapshots of the directories.
+type Snapshotter interface {
+	// AllowSnapshots makes the named directory snapshottable. This requires
+	// superuser privileges.
+	AllowSnapshots(dir string) error
+
+	// DisallowSnapshots makes the named directory not snapshottable. This
+	// requires superuser privileges.
+	DisallowSnapshots(dir string) error
+
+	// CreateSnapshot takes a snapshot of the named directory and returns the
+	// path of the snapshot. If name is empty, the namenode generates one.
+	CreateSnapshot(dir, name string) (string, error)
+
+	// DeleteSnapshot deletes a snapshot of the named directory.
+	DeleteSnapshot(dir, name string) error
+
+	// RenameSnapshot renames a snapshot of the named directory.
+	RenameSnapshot(dir, oldName, newName string) error
+
+	// Snapshots returns the snapshots of the named directory sorted by name.
+	Snapshots(dir string) ([]os.FileInfo, error)
+
+	// OpenSnapshot returns a read-only filesystem rooted at a snapshot of
+	// the named directory. It shares the connection of the filesystem it
+	// was opened from, and must not be used after the latter is closed.
+	OpenSnapshot(dir, name string) (extfs.Filesystem, error)
+}
+
+type renameSnapshotRequest struct {
+	SnapshotRoot    string `json:"snapshotRoot"`
+	SnapshotOldName string `json:"snapshotOldName"`
+	SnapshotNewName string `json:"snapshotNewName"`
+}
+
+func (fs *hadoop) AllowSnapshots(dir string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, dir)
+	if err != nil {
+		return err
+	}
+
+	return fs.retry.doOnce(func() error {
+		return fs.client.AllowSnapshots(fullpath)
+	})
+}
+
+func (fs *hadoop) DisallowSnapshots(dir string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, dir)
+	if err != nil {
+		return err
+	}
+
+	return fs.retry.doOnce(func() error {
+		return fs.client.DisallowSnapshots(fullpath)
+	})
+}
+
+func (fs *hadoop) CreateSnapshot(dir, name string) (string, error) {
+	if name != "" && !isSnapshotName(name) {
+		return "", &os.PathError{Op: "create snapshot", Path: name, Err: os.ErrInvalid}
+	}
+
+	fullpath, err := util.UnderlyingPath(fs.base, dir)
+	if err != nil {
+		return "", err
+	}
+
+	var snapshotPath string
+	err = fs.retry.doOnce(func() (err error) {
+		snapshotPath, err = fs.client.CreateSnapshot(fullpath, name)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Rel(fs.base, snapshotPath)
+}
+
+func (fs *hadoop) DeleteSnapshot(dir, name string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, dir)
+	if err != nil {
+		return err
+	}
+
+	return fs.retry.doOnce(func() error {
+		return fs.client.DeleteSnapshot(fullpath, name)
+	})
+}
+
+func (fs *hadoop) RenameSnapshot(dir, oldName, newName string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, dir)
+	if err != nil {
+		return err
+	}
+
+	req := &renameSnapshotRequest{
+		SnapshotRoot:    fullpath,
+		SnapshotOldName: oldName,
+		SnapshotNewName: newName,
+	}
+	err = fs.namenode.call("renameSnapshot", req, nil)
+	if err != nil {
+		return &os.PathError{Op: "rename snapshot", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
+
+func (fs *hadoop) Snapshots(dir string) ([]os.FileInfo, error) {
+	return fs.ReadDir(filepath.Join(dir, snapshotDir))
+}
+
+func (fs *hadoop) OpenSnapshot(dir, name string) (extfs.Filesystem, error) {
+	if !isSnapshotName(name) {
+		return nil, &os.PathError{Op: "open snapshot", Path: name, Err: os.ErrInvalid}
+	}
+
+	fullpath, err := util.UnderlyingPath(fs.base, filepath.Join(dir, snapshotDir, name))
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, &os.PathError{Op: "open snapshot", Path: fullpath, Err: os.ErrNotExist}
+	}
+
+	return &snapshot{&hadoop{client: fs.client, namenode: fs.namenode, retry: fs.retry, base: fullpath}}, nil
+}
+
+// isSnapshotName reports whether name is the name of a snapshot. The name
+// must not climb out of the snapshot directory, otherwise the read-only
+// filesystem of a snapshot would be rooted at a live directory.
+func isSnapshotName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.Contains(name, "/")
+}
+
+// snapshot is a read-only filesystem rooted at a snapshot.
+type snapshot struct {
+	fs *hadoop
+}
+
+func (s *snapshot) Create(filename string) (extfs.File, error) {
+	return nil, extfs.ErrReadOnly
+}
+
+func (s *snapshot) Open(filename string) (extfs.File, error) {
+	return s.fs.Open(filename)
+}
+
+func (s *snapshot) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_APPEND|os.O_CREATE|os.O_TRUNC) != 0 {
+		return nil, extfs.ErrReadOnly
+	}
+
+	return s.fs.Open(filename)
+}
+
+func (s *snapshot) Remove(filename string) error {
+	return extfs.ErrReadOnly
+}
+
+func (s *snapshot) RemoveAll(path string) error {
+	return extfs.ErrReadOnly
+}
+
+func (s *snapshot) Rename(oldpath, newpath string) error {
+	return extfs.ErrReadOnly
+}
+
+func (s *snapshot) Stat(filename string) (os.FileInfo, error) {
+	return s.fs.Stat(filename)
+}
+
+func (s *snapshot) ReadDir(path string) ([]os.FileInfo, error) {
+	return s.fs.ReadDir(path)
+}
+
+func (s *snapshot) MkdirAll(path string, perm os.FileMode) error {
+	return extfs.ErrReadOnly
+}
+
+func (s *snapshot) Chmod(name string, mode os.FileMode) error {
+	return extfs.ErrReadOnly
+}
+
+func (s *snapshot) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return extfs.ErrReadOnly
+}
+
+func (s *snapshot) Usage(path string) (*extfs.ContentSummary, error) {
+	return s.fs.Usage(path)
+}
+
+// Close does nothing, the connection belongs to the parent filesystem.
+func (s *snapshot) Close() error {
+	return nil
+}
@@ -0,0 +1,29 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+)
+
+func (fs *hadoop) StatFS() (*extfs.FsStat, error) {
+	var info hdfs.FsInfo
+	err := fs.retry.do(func() (err error) {
+		info, err = fs.client.StatFs()
+		return err
+	})
+	if err != nil {
+		return nil, interpretException(err)
+	}
+
+	return &extfs.FsStat{
+		Capacity:  info.Capacity,
+		Used:      info.Used,
+		Remaining: info.Remaining,
+	}, nil
+}
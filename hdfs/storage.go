@@ -0,0 +1,234 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// CreateOptions holds the per-file options of CreateWithOptions. The zero
+// values stand for the defaults of the cluster.
+type CreateOptions struct {
+	// Replication is the number of replicas of each block.
+	Replication int
+
+	// BlockSize is the size of the blocks in bytes.
+	BlockSize int64
+
+	// Perm is the permission of the file, 0644 if zero.
+	Perm os.FileMode
+
+	// Overwrite atomically replaces the file if it already exists.
+	Overwrite bool
+}
+
+// StorageManager is implemented by the hadoop filesystem to control how the
+// blocks of the files are stored.
+type StorageManager interface {
+	// CreateWithOptions creates the named file for writing with the given
+	// options.
+	CreateWithOptions(filename string, opts CreateOptions) (extfs.File, error)
+
+	// SetReplication changes the number of replicas of the named file.
+	SetReplication(name string, replication int) error
+
+	// SetStoragePolicy sets the storage policy (HOT, COLD, ALL_SSD...) of
+	// the named file or directory.
+	SetStoragePolicy(name, policy string) error
+
+	// GetStoragePolicy returns the name of the storage policy of the named
+	// file or directory.
+	GetStoragePolicy(name string) (string, error)
+}
+
+const (
+	createFlagCreate    = 0x01
+	createFlagOverwrite = 0x02
+)
+
+type createRequest struct {
+	Src    string `json:"src"`
+	Masked struct {
+		Perm uint32 `json:"perm"`
+	} `json:"masked"`
+	ClientName   string `json:"clientName"`
+	CreateFlag   uint32 `json:"createFlag"`
+	CreateParent bool   `json:"createParent"`
+	Replication  int    `json:"replication"`
+	BlockSize    int64  `json:"blockSize,string"`
+}
+
+type createResponse struct {
+	Fs struct {
+		FileID uint64 `json:"fileId,string"`
+	} `json:"fs"`
+}
+
+type completeRequest struct {
+	Src        string `json:"src"`
+	ClientName string `json:"clientName"`
+	FileID     uint64 `json:"fileId,string"`
+}
+
+type completeResponse struct {
+	Result bool `json:"result"`
+}
+
+type setReplicationRequest struct {
+	Src         string `json:"src"`
+	Replication int    `json:"replication"`
+}
+
+type setReplicationResponse struct {
+	Result bool `json:"result"`
+}
+
+type setStoragePolicyRequest struct {
+	Src        string `json:"src"`
+	PolicyName string `json:"policyName"`
+}
+
+type getStoragePolicyRequest struct {
+	Path string `json:"path"`
+}
+
+type getStoragePolicyResponse struct {
+	StoragePolicy struct {
+		Name string `json:"name"`
+	} `json:"storagePolicy"`
+}
+
+func (fs *hadoop) CreateWithOptions(filename string, opts CreateOptions) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Replication == 0 || opts.BlockSize == 0 {
+		var defaults hdfs.ServerDefaults
+		err := fs.retry.do(func() (err error) {
+			defaults, err = fs.client.ServerDefaults()
+			return err
+		})
+		if err != nil {
+			return nil, interpretException(err)
+		}
+		if opts.Replication == 0 {
+			opts.Replication = defaults.Replication
+		}
+		if opts.BlockSize == 0 {
+			opts.BlockSize = defaults.BlockSize
+		}
+	}
+	if opts.Perm == 0 {
+		opts.Perm = 0644
+	}
+
+	err = fs.mkdirAll(filepath.Dir(fullpath))
+	if err != nil {
+		return nil, interpretQuotaException(err)
+	}
+
+	if opts.Overwrite {
+		return fs.overwrite(fullpath, opts)
+	}
+
+	var fw *hdfs.FileWriter
+	err = fs.retry.doOnce(func() (err error) {
+		fw, err = fs.client.CreateFile(fullpath, opts.Replication, opts.BlockSize, opts.Perm)
+		return err
+	})
+	if err != nil {
+		return nil, interpretQuotaException(err)
+	}
+
+	return newFile(nil, fw), nil
+}
+
+// overwrite creates the file with the OVERWRITE flag, which replaces an
+// existing file in a single request. The client library only creates new
+// files, so the empty file is completed and then opened for append.
+func (fs *hadoop) overwrite(fullpath string, opts CreateOptions) (extfs.File, error) {
+	req := &createRequest{
+		Src:         fullpath,
+		ClientName:  fs.client.Name(),
+		CreateFlag:  createFlagCreate | createFlagOverwrite,
+		Replication: opts.Replication,
+		BlockSize:   opts.BlockSize,
+	}
+	req.Masked.Perm = uint32(opts.Perm.Perm())
+	resp := &createResponse{}
+	err := fs.namenode.call("create", req, resp)
+	if err != nil {
+		return nil, &os.PathError{Op: "create", Path: fullpath, Err: interpretQuotaException(interpretException(err))}
+	}
+
+	completeResp := &completeResponse{}
+	err = fs.namenode.call("complete", &completeRequest{fullpath, fs.client.Name(), resp.Fs.FileID}, completeResp)
+	if err == nil && !completeResp.Result {
+		err = errors.New("The file could not be completed")
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "create", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return fs.appendFile(fullpath)
+}
+
+func (fs *hadoop) SetReplication(name string, replication int) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	resp := &setReplicationResponse{}
+	err = fs.namenode.call("setReplication", &setReplicationRequest{fullpath, replication}, resp)
+	if err != nil {
+		return &os.PathError{Op: "setreplication", Path: fullpath, Err: interpretQuotaException(interpretException(err))}
+	}
+	if !resp.Result {
+		// The namenode does not fail for directories, it only returns false.
+		return &os.PathError{Op: "setreplication", Path: fullpath, Err: os.ErrInvalid}
+	}
+
+	return nil
+}
+
+func (fs *hadoop) SetStoragePolicy(name, policy string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	err = fs.namenode.call("setStoragePolicy", &setStoragePolicyRequest{fullpath, policy}, nil)
+	if err != nil {
+		return &os.PathError{Op: "setstoragepolicy", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
+
+func (fs *hadoop) GetStoragePolicy(name string) (string, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return "", err
+	}
+
+	resp := &getStoragePolicyResponse{}
+	err = fs.namenode.call("getStoragePolicy", &getStoragePolicyRequest{fullpath}, resp)
+	if err != nil {
+		return "", &os.PathError{Op: "getstoragepolicy", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return resp.StoragePolicy.Name, nil
+}
@@ -0,0 +1,31 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hdfs
+
+import (
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+func (fs *hadoop) Usage(path string) (*extfs.ContentSummary, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := fs.contentSummary(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extfs.ContentSummary{
+		Length:         cs.Size(),
+		FileCount:      int64(cs.FileCount()),
+		DirectoryCount: int64(cs.DirectoryCount()),
+		SpaceConsumed:  cs.SizeAfterReplication(),
+	}, nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpfs
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// StatusError is the error returned when a HTTP request fails.
+type StatusError struct {
+	Method     string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %d %s", e.Method, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// interpretError maps the statuses of HTTP to the errors of the os package.
+func interpretError(err error) error {
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return os.ErrNotExist
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return os.ErrPermission
+	default:
+		return err
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpfs
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+var errClosed = errors.New("HTTP file already closed")
+
+// file is a file opened for reading. It sends a GET from its offset on the
+// first read, and a ranged GET for each ReadAt. The size is negative when
+// the server did not tell it.
+type file struct {
+	fs       *httpfs
+	name     string
+	fullpath string
+	closed   bool
+	reader   *util.RangeReader
+}
+
+func newReader(fs *httpfs, name, fullpath string, size int64) *file {
+	open := func(off, length int64) (io.ReadCloser, error) {
+		body, err := util.OpenRange(func(header http.Header) (*http.Response, error) {
+			return fs.request(http.MethodGet, fs.url(fullpath), header)
+		}, off, length)
+		if isRangeNotSatisfiable(err) {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, &os.PathError{Op: "read", Path: fullpath, Err: interpretError(err)}
+		}
+		return body, nil
+	}
+
+	return &file{fs: fs, name: name, fullpath: fullpath, reader: &util.RangeReader{Path: fullpath, Size: size, Open: open}}
+}
+
+func (f *file) Close() error {
+	if f.closed {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: errClosed}
+	}
+	f.closed = true
+
+	return f.reader.Close()
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Read(p)
+}
+
+func (f *file) ReadAt(p []byte, off int64) (int, error) {
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.ReadAt(p, off)
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	if f.closed {
+		return 0, &os.PathError{Op: "seek", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	return 0, extfs.ErrReadOnly
+}
+
+func (f *file) WriteAt(p []byte, off int64) (int, error) {
+	return 0, extfs.ErrReadOnly
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+func (f *file) Stat() (os.FileInfo, error) {
+	return f.fs.stat(f.fullpath)
+}
+
+func (f *file) Sync() error {
+	return nil
+}
+
+func (f *file) Truncate(size int64) error {
+	return extfs.ErrReadOnly
+}
+
+// isRangeNotSatisfiable reports whether a read started past the end of a
+// file of unknown size.
+func isRangeNotSatisfiable(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusRequestedRangeNotSatisfiable
+}
@@ -0,0 +1,262 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package httpfs implements a read-only filesystem over a static HTTP
+// server.
+package httpfs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+const (
+	defaultPort       = "80"
+	defaultSecurePort = "443"
+	maxIndexSize      = 16 << 20
+)
+
+// httpfs is a read-only filesystem based on the GET and HEAD requests of a
+// HTTP server.
+type httpfs struct {
+	client    *http.Client
+	endpoint  *url.URL
+	user      string
+	password  string
+	autoindex bool
+	base      string
+}
+
+// New returns a HTTP filesystem. The endpoint is the URL of the root of
+// the server, for example https://data.example.com/datasets. The requests
+// use the basic authentication if the configuration has a user.
+func New(endpoint, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("Unsupported HTTP scheme %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, errors.New("HTTP endpoint has no host")
+	}
+	if u.Port() == "" {
+		port := defaultPort
+		if u.Scheme == "https" {
+			port = defaultSecurePort
+		}
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       cfg.TLSConfig,
+		ResponseHeaderTimeout: cfg.RPCTimeout,
+	}
+
+	return &httpfs{
+		client:    &http.Client{Transport: transport},
+		endpoint:  &url.URL{Scheme: u.Scheme, Host: u.Host, Path: strings.TrimSuffix(u.Path, "/")},
+		user:      cfg.User,
+		password:  cfg.Password,
+		autoindex: cfg.HTTPAutoindex,
+		base:      baseDir,
+	}, nil
+}
+
+func (fs *httpfs) Create(filename string) (extfs.File, error) {
+	return nil, extfs.ErrReadOnly
+}
+
+func (fs *httpfs) Open(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath)
+}
+
+func (fs *httpfs) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_APPEND|os.O_CREATE|os.O_TRUNC) != 0 {
+		return nil, extfs.ErrReadOnly
+	}
+
+	return fs.Open(filename)
+}
+
+func (fs *httpfs) Remove(filename string) error {
+	return extfs.ErrReadOnly
+}
+
+func (fs *httpfs) RemoveAll(path string) error {
+	return extfs.ErrReadOnly
+}
+
+func (fs *httpfs) Rename(oldpath, newpath string) error {
+	return extfs.ErrReadOnly
+}
+
+// Stat returns the size and the modification time of a file from the
+// Content-Length and Last-Modified headers of a HEAD request. The servers
+// redirect the directories to their path with a trailing slash.
+func (fs *httpfs) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.stat(fullpath)
+}
+
+// ReadDir lists a directory from its index page, and stats each of its
+// files. It returns extfs.ErrUnsupported unless HTTPAutoindex is set.
+func (fs *httpfs) ReadDir(dirname string) ([]os.FileInfo, error) {
+	if !fs.autoindex {
+		return nil, extfs.ErrUnsupported
+	}
+
+	fullpath, err := util.UnderlyingPath(fs.base, dirname)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := fs.url(fullpath)
+	if !strings.HasSuffix(dir.Path, "/") {
+		dir.Path += "/"
+	}
+	resp, err := fs.request(http.MethodGet, dir, nil)
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretError(err)}
+	}
+	page, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxIndexSize))
+	resp.Body.Close()
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: err}
+	}
+
+	entries := parseIndex(string(page), resp.Request.URL)
+	fis := make([]os.FileInfo, 0, len(entries))
+	for _, e := range entries {
+		if e.dir {
+			fis = append(fis, newDirInfo(e.name))
+			continue
+		}
+
+		fi, err := fs.stat(path.Join(fullpath, e.name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		fis = append(fis, fi)
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+func (fs *httpfs) MkdirAll(path string, perm os.FileMode) error {
+	return extfs.ErrReadOnly
+}
+
+func (fs *httpfs) Chmod(name string, mode os.FileMode) error {
+	return extfs.ErrReadOnly
+}
+
+func (fs *httpfs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return extfs.ErrReadOnly
+}
+
+func (fs *httpfs) Close() error {
+	fs.client.CloseIdleConnections()
+	return nil
+}
+
+func (fs *httpfs) openFile(name, fullpath string) (extfs.File, error) {
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+	}
+
+	return newReader(fs, name, fullpath, fi.Size()), nil
+}
+
+func (fs *httpfs) stat(fullpath string) (os.FileInfo, error) {
+	resp, err := fs.request(http.MethodHead, fs.url(fullpath), nil)
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretError(err)}
+	}
+	resp.Body.Close()
+
+	name := path.Base(fullpath)
+	if fullpath == "/" || strings.HasSuffix(resp.Request.URL.Path, "/") {
+		return newDirInfo(name), nil
+	}
+	if resp.ContentLength >= 0 {
+		resp.Header.Set("Content-Length", fmt.Sprint(resp.ContentLength))
+	}
+
+	return newFileInfo(name, resp.Header), nil
+}
+
+// url returns the URL of a file.
+func (fs *httpfs) url(fullpath string) *url.URL {
+	u := *fs.endpoint
+	u.Path = fs.endpoint.Path + fullpath
+	return &u
+}
+
+// request sends a request without a body. The response is a *StatusError
+// if its status is not a success.
+func (fs *httpfs) request(method string, u *url.URL, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequest(method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if fs.user != "" {
+		req.SetBasicAuth(fs.user, fs.password)
+	}
+
+	resp, err := fs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		resp.Body.Close()
+		return nil, &StatusError{Method: method, StatusCode: resp.StatusCode}
+	}
+
+	return resp, nil
+}
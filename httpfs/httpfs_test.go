@@ -0,0 +1,234 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpfs
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/extfstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUser     = "cloudchain"
+	testPassword = "secret"
+)
+
+var (
+	server  *httptest.Server
+	dataDir string
+
+	mu       sync.Mutex
+	requests = make(map[string]int)
+)
+
+// TestMain serves a directory with the file server of net/http, which
+// answers the ranges and lists the directories. The paths under /norange
+// are served without the support of the ranges.
+func TestMain(m *testing.M) {
+	extfstest.Main(m, startServer)
+}
+
+func startServer() (func(), error) {
+	var err error
+	dataDir, err = ioutil.TempDir("", "httpfs")
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{
+		"hello.txt":             "Hello world",
+		"datasets/a.csv":        "a,b\n1,2\n",
+		"datasets/b&c.csv":      "b,c\n",
+		"datasets/sub/deep.txt": "deep",
+	}
+	for name, data := range files {
+		p := filepath.Join(dataDir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			os.RemoveAll(dataDir)
+			return nil, err
+		}
+		if err := ioutil.WriteFile(p, []byte(data), 0644); err != nil {
+			os.RemoveAll(dataDir)
+			return nil, err
+		}
+	}
+	mtime := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	os.Chtimes(filepath.Join(dataDir, "hello.txt"), mtime, mtime)
+
+	fileServer := http.FileServer(http.Dir(dataDir))
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method]++
+		mu.Unlock()
+
+		user, password, ok := r.BasicAuth()
+		if !ok || user != testUser || password != testPassword {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/norange/") {
+			r.URL.Path = strings.TrimPrefix(r.URL.Path, "/norange")
+			r.Header.Del("Range")
+		}
+		fileServer.ServeHTTP(w, r)
+	}))
+
+	return func() {
+		server.Close()
+		os.RemoveAll(dataDir)
+	}, nil
+}
+
+func newFilesystem(t *testing.T, base string) extfs.Filesystem {
+	fs, err := New(server.URL, base, &extfs.Config{User: testUser, Password: testPassword, HTTPAutoindex: true})
+	require.NoError(t, err)
+
+	return fs
+}
+
+func requestCount(method string) int {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return requests[method]
+}
+
+func TestStat(t *testing.T) {
+	fs := newFilesystem(t, "/")
+	defer fs.Close()
+
+	heads := requestCount(http.MethodHead)
+	fi, err := fs.Stat("hello.txt")
+	require.NoError(t, err)
+	assert.Equal(t, heads+1, requestCount(http.MethodHead))
+	assert.Equal(t, "hello.txt", fi.Name())
+	assert.Equal(t, int64(11), fi.Size())
+	assert.False(t, fi.IsDir())
+	assert.Equal(t, os.FileMode(0444), fi.Mode())
+	assert.True(t, time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC).Equal(fi.ModTime()))
+	assert.Equal(t, "text/plain; charset=utf-8", fi.Sys().(http.Header).Get("Content-Type"))
+
+	fi, err = fs.Stat("datasets")
+	require.NoError(t, err)
+	assert.True(t, fi.IsDir())
+
+	_, err = fs.Stat("missing.txt")
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = fs.Stat("../escape.txt")
+	assert.Equal(t, extfs.ErrCrossedBoundary, err)
+
+	_, err = fs.Open("datasets")
+	assert.Equal(t, syscall.EISDIR, err.(*os.PathError).Err)
+}
+
+func TestRead(t *testing.T) {
+	for _, base := range []string{"/", "/norange"} {
+		t.Run(base, func(t *testing.T) {
+			fs := newFilesystem(t, base)
+			defer fs.Close()
+
+			extfstest.TestRead(t, fs, "hello.txt")
+		})
+	}
+}
+
+func TestReadDir(t *testing.T) {
+	fs := newFilesystem(t, "/")
+	defer fs.Close()
+
+	fis, err := fs.ReadDir("datasets")
+	require.NoError(t, err)
+	var names []string
+	for _, fi := range fis {
+		names = append(names, fi.Name())
+	}
+	assert.Equal(t, []string{"a.csv", "b&c.csv", "sub"}, names)
+	assert.Equal(t, int64(8), fis[0].Size())
+	assert.True(t, fis[2].IsDir())
+
+	_, err = fs.ReadDir("missing")
+	assert.True(t, os.IsNotExist(err))
+
+	fs, err = New(server.URL, "/", &extfs.Config{User: testUser, Password: testPassword})
+	require.NoError(t, err)
+	defer fs.Close()
+	_, err = fs.ReadDir("datasets")
+	assert.Equal(t, extfs.ErrUnsupported, err)
+}
+
+func TestReadOnly(t *testing.T) {
+	fs := newFilesystem(t, "/")
+	defer fs.Close()
+
+	_, err := fs.Create("new.txt")
+	assert.Equal(t, extfs.ErrReadOnly, err)
+	_, err = fs.OpenFile("hello.txt", os.O_WRONLY|os.O_TRUNC, 0)
+	assert.Equal(t, extfs.ErrReadOnly, err)
+	assert.Equal(t, extfs.ErrReadOnly, fs.Remove("hello.txt"))
+	assert.Equal(t, extfs.ErrReadOnly, fs.RemoveAll("datasets"))
+	assert.Equal(t, extfs.ErrReadOnly, fs.Rename("hello.txt", "bye.txt"))
+	assert.Equal(t, extfs.ErrReadOnly, fs.MkdirAll("dir", 0755))
+	assert.Equal(t, extfs.ErrReadOnly, fs.Chmod("hello.txt", 0600))
+	assert.Equal(t, extfs.ErrReadOnly, fs.Chtimes("hello.txt", time.Now(), time.Now()))
+
+	f, err := fs.OpenFile("hello.txt", os.O_RDONLY, 0)
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.Write([]byte("x"))
+	assert.Equal(t, extfs.ErrReadOnly, err)
+	assert.Equal(t, extfs.ErrReadOnly, f.Truncate(0))
+}
+
+func TestAuthentication(t *testing.T) {
+	fs, err := New(server.URL, "/", &extfs.Config{User: testUser, Password: "wrong"})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	_, err = fs.Stat("hello.txt")
+	assert.True(t, os.IsPermission(err))
+
+	_, err = New("ftp://example.com", "/", nil)
+	assert.Error(t, err)
+}
+
+func TestParseIndex(t *testing.T) {
+	dir, err := url.Parse("http://example.com/data/")
+	require.NoError(t, err)
+
+	// Apache
+	page := `<html><body><h1>Index of /data</h1><table>
+<tr><th><a href="?C=N;O=D">Name</a></th><th><a href="?C=M;O=A">Last modified</a></th></tr>
+<tr><td><a href="/">Parent Directory</a></td></tr>
+<tr><td><a href="my%20file.txt">my file.txt</a></td><td>2019-06-01 12:00</td></tr>
+<tr><td><a href="sub/">sub/</a></td><td>2019-06-01 12:00</td></tr>
+</table></body></html>`
+	assert.Equal(t, []entry{{name: "my file.txt"}, {name: "sub", dir: true}}, parseIndex(page, dir))
+
+	// nginx
+	page = `<html><body><h1>Index of /data/</h1><hr><pre><a href="../">../</a>
+<a href='a&amp;b.csv'>a&amp;b.csv</a>                                 01-Jun-2019 12:00       8
+<a href="http://example.com/data/c.csv">c.csv</a>
+<a href="http://other.com/data/d.csv">d.csv</a>
+<a href="sub/deep.txt">deep.txt</a>
+<a href="c.csv">c.csv</a>
+</pre><hr></body></html>`
+	assert.Equal(t, []entry{{name: "a&b.csv"}, {name: "c.csv"}}, parseIndex(page, dir))
+}
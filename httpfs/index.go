@@ -0,0 +1,58 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpfs
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var hrefPattern = regexp.MustCompile(`(?i)<a\s[^>]*?href\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+
+// entry is a link of an index page.
+type entry struct {
+	name string
+	dir  bool
+}
+
+// parseIndex returns the entries of the index page of a directory, from
+// the links to its direct children. The links to the parent, to the sort
+// orders of Apache or to other sites are ignored. The names of the
+// directories end with a slash in the links.
+func parseIndex(page string, dir *url.URL) []entry {
+	var entries []entry
+	seen := make(map[string]bool)
+	for _, match := range hrefPattern.FindAllStringSubmatch(page, -1) {
+		href := match[1]
+		if href == "" {
+			href = match[2]
+		}
+
+		u, err := url.Parse(html.UnescapeString(href))
+		if err != nil {
+			continue
+		}
+		u = dir.ResolveReference(u)
+		if u.Scheme != dir.Scheme || u.Host != dir.Host || !strings.HasPrefix(u.Path, dir.Path) {
+			continue
+		}
+
+		name := u.Path[len(dir.Path):]
+		isDir := strings.HasSuffix(name, "/")
+		name = strings.TrimSuffix(name, "/")
+		if name == "" || strings.Contains(name, "/") || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		entries = append(entries, entry{name: name, dir: isDir})
+	}
+
+	return entries
+}
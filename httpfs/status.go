@@ -0,0 +1,79 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpfs
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultFileMode      = 0444
+	defaultDirectoryMode = 0555
+)
+
+// fileInfo describes a file from the headers of its HEAD response, or a
+// directory. Sys() returns the headers, which are nil for the directories.
+type fileInfo struct {
+	name   string
+	dir    bool
+	header http.Header
+}
+
+func newFileInfo(name string, header http.Header) *fileInfo {
+	return &fileInfo{name: name, header: header}
+}
+
+func newDirInfo(name string) *fileInfo {
+	return &fileInfo{name: name, dir: true}
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+// Size returns the Content-Length of the file, or -1 if the server did not
+// send it.
+func (fi *fileInfo) Size() int64 {
+	if fi.dir {
+		return 0
+	}
+
+	size, err := strconv.ParseInt(fi.header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return size
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	if fi.dir {
+		return os.ModeDir | defaultDirectoryMode
+	}
+
+	return defaultFileMode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	if fi.dir {
+		return time.Time{}
+	}
+
+	mtime, _ := http.ParseTime(fi.header.Get("Last-Modified"))
+	return mtime
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.dir
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return fi.header
+}
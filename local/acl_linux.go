@@ -0,0 +1,393 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"sort"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Linux stores POSIX ACLs in extended attributes, as a version header
+// followed by (tag, perm, id) records sorted by tag and id.
+const (
+	aclXattrAccess  = "system.posix_acl_access"
+	aclXattrDefault = "system.posix_acl_default"
+
+	aclVersion     = 2
+	aclUndefinedID = 0xffffffff
+
+	aclTagUserObj  = 0x01
+	aclTagUser     = 0x02
+	aclTagGroupObj = 0x04
+	aclTagGroup    = 0x08
+	aclTagMask     = 0x10
+	aclTagOther    = 0x20
+)
+
+var errInvalidACL = errors.New("Invalid ACL")
+
+// GetACL ...
+func (fs *local) GetACL(name string) (*extfs.ACLStatus, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	st := fi.Sys().(*syscall.Stat_t)
+
+	status := &extfs.ACLStatus{
+		Owner:      userName(st.Uid),
+		Group:      groupName(st.Gid),
+		Sticky:     fi.Mode()&os.ModeSticky != 0,
+		Permission: fi.Mode().Perm(),
+	}
+
+	access, err := readACL(fullpath, aclXattrAccess, extfs.ACLAccess)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range access {
+		if e.Type == extfs.ACLGroup || e.Name != "" {
+			status.Entries = append(status.Entries, e)
+		}
+	}
+
+	def, err := readACL(fullpath, aclXattrDefault, extfs.ACLDefault)
+	if err != nil {
+		return nil, err
+	}
+	status.Entries = append(status.Entries, def...)
+
+	return status, nil
+}
+
+// SetACL ...
+func (fs *local) SetACL(name string, entries []extfs.ACLEntry) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	base, err := baseACL(fullpath)
+	if err != nil {
+		return err
+	}
+
+	access, def := splitACL(entries)
+	if len(access) == 0 {
+		access = base
+	}
+
+	access = completeACL(access, base)
+	return writeACL(fullpath, access, completeACL(def, access))
+}
+
+// ModifyACLEntries ...
+func (fs *local) ModifyACLEntries(name string, entries []extfs.ACLEntry) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	base, err := baseACL(fullpath)
+	if err != nil {
+		return err
+	}
+	def, err := readACL(fullpath, aclXattrDefault, extfs.ACLDefault)
+	if err != nil {
+		return err
+	}
+
+	access := completeACL(mergeACL(base, entries, extfs.ACLAccess), base)
+	def = completeACL(mergeACL(def, entries, extfs.ACLDefault), access)
+	return writeACL(fullpath, access, def)
+}
+
+// RemoveACL ...
+func (fs *local) RemoveACL(name string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	fi, err := os.Stat(fullpath)
+	if err != nil {
+		return err
+	}
+	base, err := baseACL(fullpath)
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range []string{aclXattrAccess, aclXattrDefault} {
+		err = syscall.Removexattr(fullpath, attr)
+		if err != nil && err != syscall.ENODATA {
+			return &os.PathError{Op: "removexattr", Path: fullpath, Err: err}
+		}
+	}
+
+	// Without the ACL, the group class bits of the mode go back to the owning
+	// group permission instead of the mask.
+	mode := fi.Mode() &^ 070
+	for _, e := range base {
+		if e.Type == extfs.ACLGroup && e.Name == "" {
+			mode |= e.Perm << 3
+		}
+	}
+
+	return os.Chmod(fullpath, mode)
+}
+
+// baseACL returns the current access ACL of the file, or the ACL equivalent
+// to its permission bits when it has none.
+func baseACL(fullpath string) ([]extfs.ACLEntry, error) {
+	access, err := readACL(fullpath, aclXattrAccess, extfs.ACLAccess)
+	if err != nil || access != nil {
+		return access, err
+	}
+
+	fi, err := os.Stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	perm := fi.Mode().Perm()
+	return []extfs.ACLEntry{
+		{Scope: extfs.ACLAccess, Type: extfs.ACLUser, Perm: perm >> 6 & 07},
+		{Scope: extfs.ACLAccess, Type: extfs.ACLGroup, Perm: perm >> 3 & 07},
+		{Scope: extfs.ACLAccess, Type: extfs.ACLOther, Perm: perm & 07},
+	}, nil
+}
+
+func splitACL(entries []extfs.ACLEntry) (access, def []extfs.ACLEntry) {
+	for _, e := range entries {
+		if e.Scope == extfs.ACLDefault {
+			def = append(def, e)
+		} else {
+			access = append(access, e)
+		}
+	}
+
+	return access, def
+}
+
+// mergeACL replaces or adds the entries of the given scope in acl. The mask
+// is dropped unless given explicitly so that completeACL recomputes it.
+func mergeACL(acl []extfs.ACLEntry, entries []extfs.ACLEntry, scope extfs.ACLScope) []extfs.ACLEntry {
+	var merged []extfs.ACLEntry
+	for _, e := range acl {
+		if e.Type != extfs.ACLMask {
+			merged = append(merged, e)
+		}
+	}
+
+	for _, e := range entries {
+		if e.Scope != scope {
+			continue
+		}
+
+		replaced := false
+		for i := range merged {
+			if merged[i].Type == e.Type && merged[i].Name == e.Name {
+				merged[i], replaced = e, true
+			}
+		}
+		if !replaced {
+			merged = append(merged, e)
+		}
+	}
+
+	return merged
+}
+
+// completeACL fills the owner, group and other entries missing in acl from
+// base, and adds a mask covering the group class if acl has named entries.
+// An empty acl stays empty.
+func completeACL(acl []extfs.ACLEntry, base []extfs.ACLEntry) []extfs.ACLEntry {
+	if len(acl) == 0 {
+		return nil
+	}
+
+	scope := acl[0].Scope
+	has := func(t extfs.ACLType) bool {
+		for _, e := range acl {
+			if e.Type == t && e.Name == "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, b := range base {
+		if b.Name == "" && b.Type != extfs.ACLMask && !has(b.Type) {
+			b.Scope = scope
+			acl = append(acl, b)
+		}
+	}
+
+	if !has(extfs.ACLMask) {
+		var mask os.FileMode
+		named := false
+		for _, e := range acl {
+			if e.Name != "" || e.Type == extfs.ACLGroup {
+				mask |= e.Perm
+			}
+			named = named || e.Name != ""
+		}
+		if named {
+			acl = append(acl, extfs.ACLEntry{Scope: scope, Type: extfs.ACLMask, Perm: mask})
+		}
+	}
+
+	return acl
+}
+
+func readACL(fullpath, attr string, scope extfs.ACLScope) ([]extfs.ACLEntry, error) {
+	sz, err := syscall.Getxattr(fullpath, attr, nil)
+	if err == syscall.ENODATA {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "getxattr", Path: fullpath, Err: err}
+	}
+
+	buf := make([]byte, sz)
+	sz, err = syscall.Getxattr(fullpath, attr, buf)
+	if err != nil {
+		return nil, &os.PathError{Op: "getxattr", Path: fullpath, Err: err}
+	}
+	buf = buf[:sz]
+
+	if len(buf) < 4 || binary.LittleEndian.Uint32(buf) != aclVersion || (len(buf)-4)%8 != 0 {
+		return nil, &os.PathError{Op: "getxattr", Path: fullpath, Err: errInvalidACL}
+	}
+
+	var entries []extfs.ACLEntry
+	for p := buf[4:]; len(p) > 0; p = p[8:] {
+		tag := binary.LittleEndian.Uint16(p)
+		e := extfs.ACLEntry{Scope: scope, Perm: os.FileMode(binary.LittleEndian.Uint16(p[2:]) & 07)}
+		id := binary.LittleEndian.Uint32(p[4:])
+
+		switch tag {
+		case aclTagUserObj:
+			e.Type = extfs.ACLUser
+		case aclTagUser:
+			e.Type, e.Name = extfs.ACLUser, userName(id)
+		case aclTagGroupObj:
+			e.Type = extfs.ACLGroup
+		case aclTagGroup:
+			e.Type, e.Name = extfs.ACLGroup, groupName(id)
+		case aclTagMask:
+			e.Type = extfs.ACLMask
+		case aclTagOther:
+			e.Type = extfs.ACLOther
+		default:
+			return nil, &os.PathError{Op: "getxattr", Path: fullpath, Err: errInvalidACL}
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func writeACL(fullpath string, access, def []extfs.ACLEntry) error {
+	data, err := encodeACL(access)
+	if err != nil {
+		return &os.PathError{Op: "setxattr", Path: fullpath, Err: err}
+	}
+	if err = syscall.Setxattr(fullpath, aclXattrAccess, data, 0); err != nil {
+		return &os.PathError{Op: "setxattr", Path: fullpath, Err: err}
+	}
+
+	if len(def) == 0 {
+		err = syscall.Removexattr(fullpath, aclXattrDefault)
+		if err != nil && err != syscall.ENODATA {
+			return &os.PathError{Op: "removexattr", Path: fullpath, Err: err}
+		}
+		return nil
+	}
+
+	data, err = encodeACL(def)
+	if err != nil {
+		return &os.PathError{Op: "setxattr", Path: fullpath, Err: err}
+	}
+	if err = syscall.Setxattr(fullpath, aclXattrDefault, data, 0); err != nil {
+		return &os.PathError{Op: "setxattr", Path: fullpath, Err: err}
+	}
+
+	return nil
+}
+
+func encodeACL(entries []extfs.ACLEntry) ([]byte, error) {
+	type record struct {
+		tag uint16
+		id  uint32
+	}
+
+	records := make([]record, 0, len(entries))
+	perms := make(map[record]os.FileMode, len(entries))
+	for _, e := range entries {
+		r := record{id: aclUndefinedID}
+		var err error
+
+		switch {
+		case e.Type == extfs.ACLUser && e.Name == "":
+			r.tag = aclTagUserObj
+		case e.Type == extfs.ACLUser:
+			r.tag = aclTagUser
+			r.id, err = userID(e.Name)
+		case e.Type == extfs.ACLGroup && e.Name == "":
+			r.tag = aclTagGroupObj
+		case e.Type == extfs.ACLGroup:
+			r.tag = aclTagGroup
+			r.id, err = groupID(e.Name)
+		case e.Type == extfs.ACLMask:
+			r.tag = aclTagMask
+		case e.Type == extfs.ACLOther:
+			r.tag = aclTagOther
+		default:
+			err = errInvalidACL
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := perms[r]; !ok {
+			records = append(records, r)
+		}
+		perms[r] = e.Perm & 07
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].tag != records[j].tag {
+			return records[i].tag < records[j].tag
+		}
+		return records[i].id < records[j].id
+	})
+
+	buf := make([]byte, 4, 4+8*len(records))
+	binary.LittleEndian.PutUint32(buf, aclVersion)
+	for _, r := range records {
+		var p [8]byte
+		binary.LittleEndian.PutUint16(p[:], r.tag)
+		binary.LittleEndian.PutUint16(p[2:], uint16(perms[r]))
+		binary.LittleEndian.PutUint32(p[4:], r.id)
+		buf = append(buf, p[:]...)
+	}
+
+	return buf, nil
+}
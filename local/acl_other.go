@@ -0,0 +1,32 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import "github.com/rkcloudchain/extfs"
+
+// GetACL ...
+func (fs *local) GetACL(name string) (*extfs.ACLStatus, error) {
+	return nil, extfs.ErrUnsupported
+}
+
+// SetACL ...
+func (fs *local) SetACL(name string, entries []extfs.ACLEntry) error {
+	return extfs.ErrUnsupported
+}
+
+// ModifyACLEntries ...
+func (fs *local) ModifyACLEntries(name string, entries []extfs.ACLEntry) error {
+	return extfs.ErrUnsupported
+}
+
+// RemoveACL ...
+func (fs *local) RemoveACL(name string) error {
+	return extfs.ErrUnsupported
+}
@@ -0,0 +1,37 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// BlockLocations returns the whole file as a single block on localhost.
+func (fs *local) BlockLocations(path string, offset, length int64) ([]extfs.BlockLocation, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return nil, &os.PathError{Op: "blocklocations", Path: fullpath, Err: syscall.EISDIR}
+	}
+
+	if offset >= fi.Size() || length <= 0 {
+		return []extfs.BlockLocation{}, nil
+	}
+
+	return []extfs.BlockLocation{{Offset: 0, Length: fi.Size(), Hosts: []string{"localhost"}}}, nil
+}
@@ -0,0 +1,31 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Checksum computes the checksum of the named file. The MD5MD5CRC32C checksum
+// matches the one of an HDFS file written with the default block size.
+func (fs *local) Checksum(path string, algorithm extfs.ChecksumAlgorithm) (*extfs.FileChecksum, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return extfs.ComputeChecksum(f, algorithm, extfs.DefaultBlockSize)
+}
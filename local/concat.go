@@ -0,0 +1,99 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Concat appends the sources to the target and removes them. Unlike HDFS,
+// the data is copied, and a failure leaves the sources appended so far
+// removed.
+func (fs *local) Concat(target string, sources []string) error {
+	trg, err := util.UnderlyingPath(fs.base, target)
+	if err != nil {
+		return err
+	}
+	if err = checkConcatFile(trg); err != nil {
+		return err
+	}
+
+	srcs := make([]string, 0, len(sources))
+	seen := map[string]bool{trg: true}
+	for _, source := range sources {
+		src, err := util.UnderlyingPath(fs.base, source)
+		if err != nil {
+			return err
+		}
+		if seen[src] {
+			return &os.PathError{Op: "concat", Path: src, Err: errors.New("Duplicate concat source")}
+		}
+		seen[src] = true
+
+		if err = checkConcatFile(src); err != nil {
+			return err
+		}
+		srcs = append(srcs, src)
+	}
+
+	f, err := os.OpenFile(trg, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+
+	err = appendFiles(f, srcs)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+// appendFiles appends the sources to f one after the other, removing each
+// source once its content is synced.
+func appendFiles(f *os.File, srcs []string) error {
+	for _, src := range srcs {
+		if err := appendFile(f, src); err != nil {
+			return err
+		}
+		if err := f.Sync(); err != nil {
+			return err
+		}
+		if err := os.Remove(src); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkConcatFile(fullpath string) error {
+	fi, err := os.Stat(fullpath)
+	if err != nil {
+		return err
+	}
+	if !fi.Mode().IsRegular() {
+		return &os.PathError{Op: "concat", Path: fullpath, Err: errors.New("Not a regular file")}
+	}
+
+	return nil
+}
+
+func appendFile(w io.Writer, src string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
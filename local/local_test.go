@@ -7,8 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package local
 
 import (
+	"errors"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"syscall"
 	"testing"
 
 	"github.com/rkcloudchain/extfs"
@@ -62,3 +65,194 @@ func TestRemove(t *testing.T) {
 	_, err = fs.Stat("bar/qux")
 	assert.True(t, os.IsNotExist(err))
 }
+
+func TestACL(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-local-test")
+	fs := New(tp)
+
+	f, err := fs.Create("acl/file")
+	require.NoError(t, err)
+	f.Close()
+
+	acl := fs.(extfs.ACL)
+	err = acl.ModifyACLEntries("acl/file", []extfs.ACLEntry{
+		{Scope: extfs.ACLAccess, Type: extfs.ACLUser, Name: "daemon", Perm: 04},
+	})
+	if errors.Is(err, syscall.ENOTSUP) {
+		t.Skip("POSIX ACLs are not supported by the filesystem")
+	}
+	require.NoError(t, err)
+
+	status, err := acl.GetACL("acl/file")
+	require.NoError(t, err)
+	assert.Contains(t, status.Entries, extfs.ACLEntry{Scope: extfs.ACLAccess, Type: extfs.ACLUser, Name: "daemon", Perm: 04})
+	assert.Contains(t, status.Entries, extfs.ACLEntry{Scope: extfs.ACLAccess, Type: extfs.ACLGroup, Perm: 04})
+
+	err = acl.RemoveACL("acl/file")
+	require.NoError(t, err)
+
+	status, err = acl.GetACL("acl/file")
+	require.NoError(t, err)
+	assert.Empty(t, status.Entries)
+
+	err = fs.RemoveAll("acl")
+	assert.NoError(t, err)
+}
+
+func TestUsage(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-local-test")
+	fs := New(tp)
+
+	for _, name := range []string{"usage/a", "usage/b/c", "usage/b/d/e"} {
+		f, err := fs.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("Hello world"))
+		require.NoError(t, err)
+		f.Close()
+	}
+
+	cs, err := fs.(extfs.DiskUsage).Usage("usage")
+	require.NoError(t, err)
+	assert.Equal(t, int64(33), cs.Length)
+	assert.Equal(t, int64(3), cs.FileCount)
+	assert.Equal(t, int64(3), cs.DirectoryCount)
+
+	cs, err = fs.(extfs.DiskUsage).Usage("usage/a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), cs.Length)
+	assert.Equal(t, int64(1), cs.FileCount)
+	assert.Zero(t, cs.DirectoryCount)
+
+	err = fs.RemoveAll("usage")
+	assert.NoError(t, err)
+}
+
+func TestStatFS(t *testing.T) {
+	fs := New(filepath.Join(os.TempDir(), "extfs-local-test", "not-created"))
+
+	stat, err := fs.(extfs.StatFS).StatFS()
+	require.NoError(t, err)
+	assert.NotZero(t, stat.Capacity)
+	assert.True(t, stat.Used+stat.Remaining <= stat.Capacity)
+}
+
+func TestBlockLocations(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-local-test")
+	fs := New(tp)
+
+	f, err := fs.Create("blocks.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	f.Close()
+	defer fs.Remove("blocks.txt")
+
+	locator := fs.(extfs.BlockLocator)
+	blocks, err := locator.BlockLocations("blocks.txt", 5, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []extfs.BlockLocation{{Offset: 0, Length: 11, Hosts: []string{"localhost"}}}, blocks)
+
+	blocks, err = locator.BlockLocations("blocks.txt", 11, 100)
+	require.NoError(t, err)
+	assert.Empty(t, blocks)
+}
+
+func TestChecksum(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-local-test")
+	fs := New(tp)
+
+	f, err := fs.Create("checksum.txt")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	f.Close()
+	defer fs.Remove("checksum.txt")
+
+	cs, err := fs.(extfs.Checksummer).Checksum("checksum.txt", extfs.SHA256)
+	require.NoError(t, err)
+	assert.Equal(t, "SHA-256:64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", cs.String())
+
+	cs, err = fs.(extfs.Checksummer).Checksum("checksum.txt", extfs.MD5MD5CRC32C)
+	require.NoError(t, err)
+	assert.Equal(t, extfs.MD5MD5CRC32C, cs.Algorithm)
+	assert.Len(t, cs.Sum, 16)
+}
+
+func TestConcat(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-local-test")
+	fs := New(tp)
+	defer fs.RemoveAll("concat")
+
+	for _, name := range []string{"concat/target", "concat/part1", "concat/part2"} {
+		f, err := fs.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(filepath.Base(name) + ";"))
+		require.NoError(t, err)
+		f.Close()
+	}
+
+	c := fs.(extfs.Concatenator)
+	err := c.Concat("concat/target", []string{"concat/part1", "concat/part1"})
+	assert.Error(t, err)
+
+	err = c.Concat("concat/target", []string{"concat/part1", "concat/part2"})
+	require.NoError(t, err)
+
+	data, err := ioutil.ReadFile(filepath.Join(tp, "concat/target"))
+	require.NoError(t, err)
+	assert.Equal(t, "target;part1;part2;", string(data))
+
+	_, err = fs.Stat("concat/part1")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSymlink(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-local-test")
+	fs := New(tp)
+	defer fs.RemoveAll("symlink")
+
+	f, err := fs.Create("symlink/target.txt")
+	require.NoError(t, err)
+	f.Close()
+
+	s := fs.(extfs.Symlinker)
+	err = s.Symlink("target.txt", "symlink/link.txt")
+	require.NoError(t, err)
+
+	target, err := s.Readlink("symlink/link.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "target.txt", target)
+
+	fi, err := s.Lstat("symlink/link.txt")
+	require.NoError(t, err)
+	assert.True(t, fi.Mode()&os.ModeSymlink != 0)
+	fi, err = fs.Stat("symlink/link.txt")
+	require.NoError(t, err)
+	assert.True(t, fi.Mode().IsRegular())
+
+	err = s.Symlink("target.txt", "../link.txt")
+	assert.Equal(t, extfs.ErrCrossedBoundary, err)
+}
+
+func TestOwner(t *testing.T) {
+	tp := filepath.Join(os.TempDir(), "extfs-local-test")
+	fs := New(tp)
+	defer fs.Remove("owner.txt")
+
+	f, err := fs.Create("owner.txt")
+	require.NoError(t, err)
+	f.Close()
+
+	c := fs.(extfs.Chowner)
+	user, group, err := c.Owner("owner.txt")
+	require.NoError(t, err)
+	assert.NotEmpty(t, user)
+	assert.NotEmpty(t, group)
+
+	require.NoError(t, c.Chown("owner.txt", user, ""))
+	require.NoError(t, c.Chown("owner.txt", "", group))
+	u, g, err := c.Owner("owner.txt")
+	require.NoError(t, err)
+	assert.Equal(t, user, u)
+	assert.Equal(t, group, g)
+}
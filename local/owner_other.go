@@ -0,0 +1,22 @@
+//go:build windows || plan9
+// +build windows plan9
+
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import "github.com/rkcloudchain/extfs"
+
+// Owner ...
+func (fs *local) Owner(name string) (string, string, error) {
+	return "", "", extfs.ErrUnsupported
+}
+
+// Chown ...
+func (fs *local) Chown(name, owner, group string) error {
+	return extfs.ErrUnsupported
+}
@@ -0,0 +1,98 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Owner ...
+func (fs *local) Owner(name string) (string, string, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	fi, err := os.Lstat(fullpath)
+	if err != nil {
+		return "", "", err
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "", "", extfs.ErrUnsupported
+	}
+
+	return userName(st.Uid), groupName(st.Gid), nil
+}
+
+// Chown ...
+func (fs *local) Chown(name, owner, group string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	// -1 leaves the id unchanged.
+	uid, gid := -1, -1
+	if owner != "" {
+		id, err := userID(owner)
+		if err != nil {
+			return &os.PathError{Op: "chown", Path: fullpath, Err: err}
+		}
+		uid = int(id)
+	}
+	if group != "" {
+		id, err := groupID(group)
+		if err != nil {
+			return &os.PathError{Op: "chown", Path: fullpath, Err: err}
+		}
+		gid = int(id)
+	}
+
+	return os.Lchown(fullpath, uid, gid)
+}
+
+func userName(uid uint32) string {
+	id := strconv.FormatUint(uint64(uid), 10)
+	if u, err := user.LookupId(id); err == nil {
+		return u.Username
+	}
+	return id
+}
+
+func groupName(gid uint32) string {
+	id := strconv.FormatUint(uint64(gid), 10)
+	if g, err := user.LookupGroupId(id); err == nil {
+		return g.Name
+	}
+	return id
+}
+
+func userID(name string) (uint32, error) {
+	if u, err := user.Lookup(name); err == nil {
+		name = u.Uid
+	}
+	id, err := strconv.ParseUint(name, 10, 32)
+	return uint32(id), err
+}
+
+func groupID(name string) (uint32, error) {
+	if g, err := user.LookupGroup(name); err == nil {
+		name = g.Gid
+	}
+	id, err := strconv.ParseUint(name, 10, 32)
+	return uint32(id), err
+}
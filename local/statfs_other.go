@@ -0,0 +1,17 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import "github.com/rkcloudchain/extfs"
+
+// StatFS ...
+func (fs *local) StatFS() (*extfs.FsStat, error) {
+	return nil, extfs.ErrUnsupported
+}
@@ -0,0 +1,43 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+// StatFS ...
+func (fs *local) StatFS() (*extfs.FsStat, error) {
+	// The base directory may not be created yet, use the filesystem it will
+	// be created on.
+	dir := fs.base
+	var st syscall.Statfs_t
+	for {
+		err := syscall.Statfs(dir, &st)
+		if err == nil {
+			break
+		}
+		if err != syscall.ENOENT || filepath.Dir(dir) == dir {
+			return nil, &os.PathError{Op: "statfs", Path: dir, Err: err}
+		}
+		dir = filepath.Dir(dir)
+	}
+
+	bsize := uint64(st.Bsize)
+	return &extfs.FsStat{
+		Capacity:  uint64(st.Blocks) * bsize,
+		Used:      (uint64(st.Blocks) - uint64(st.Bfree)) * bsize,
+		Remaining: uint64(st.Bavail) * bsize,
+	}, nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"os"
+
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Lstat ...
+func (fs *local) Lstat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Lstat(fullpath)
+}
+
+// Readlink ...
+func (fs *local) Readlink(name string) (string, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return "", err
+	}
+
+	return os.Readlink(fullpath)
+}
+
+// Symlink ...
+func (fs *local) Symlink(oldname, newname string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, newname)
+	if err != nil {
+		return err
+	}
+
+	if err := fs.createDir(fullpath); err != nil {
+		return err
+	}
+
+	return os.Symlink(oldname, fullpath)
+}
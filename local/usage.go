@@ -0,0 +1,101 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Usage ...
+func (fs *local) Usage(path string) (*extfs.ContentSummary, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Lstat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &usageWalker{sem: make(chan struct{}, runtime.NumCPU())}
+	w.add(fi)
+	if fi.IsDir() {
+		w.walk(fullpath)
+		w.wg.Wait()
+	}
+
+	return &w.summary, w.err
+}
+
+// usageWalker sums up a directory tree, reading the subdirectories in
+// parallel as long as there is a free slot in sem.
+type usageWalker struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+
+	mu      sync.Mutex
+	summary extfs.ContentSummary
+	err     error
+}
+
+func (w *usageWalker) walk(dir string) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		w.fail(err)
+		return
+	}
+
+	for _, fi := range entries {
+		w.add(fi)
+		if !fi.IsDir() {
+			continue
+		}
+
+		sub := filepath.Join(dir, fi.Name())
+		select {
+		case w.sem <- struct{}{}:
+			w.wg.Add(1)
+			go func() {
+				defer w.wg.Done()
+				w.walk(sub)
+				<-w.sem
+			}()
+		default:
+			w.walk(sub)
+		}
+	}
+}
+
+func (w *usageWalker) add(fi os.FileInfo) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if fi.IsDir() {
+		w.summary.DirectoryCount++
+	} else {
+		w.summary.FileCount++
+		w.summary.Length += fi.Size()
+	}
+	w.summary.SpaceConsumed += spaceConsumed(fi)
+}
+
+func (w *usageWalker) fail(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err == nil && !os.IsNotExist(err) {
+		w.err = err
+	}
+}
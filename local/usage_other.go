@@ -0,0 +1,21 @@
+//go:build windows || plan9
+// +build windows plan9
+
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package local
+
+import "os"
+
+// spaceConsumed returns the size of the file, the allocated size is not
+// available on this platform.
+func spaceConsumed(fi os.FileInfo) int64 {
+	if fi.IsDir() {
+		return 0
+	}
+	return fi.Size()
+}
@@ -0,0 +1,20 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+// Chowner is implemented by the filesystems which record the user and the
+// group owning the files.
+type Chowner interface {
+	// Owner returns the names of the user and the group owning the named
+	// file. A symbolic link itself is described.
+	Owner(name string) (user, group string, err error)
+
+	// Chown changes the user and the group owning the named file. An empty
+	// name leaves the user or the group unchanged. A symbolic link itself
+	// is changed.
+	Chown(name, user, group string) error
+}
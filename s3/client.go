@@ -0,0 +1,336 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Object is the metadata of an S3 object. It is the Sys() of the
+// os.FileInfo of the files, and of the directories having a marker object.
+type Object struct {
+	Key          string    `xml:"Key"`
+	Size         int64     `xml:"Size"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	StorageClass string    `xml:"StorageClass"`
+}
+
+type listResult struct {
+	IsTruncated           bool      `xml:"IsTruncated"`
+	NextContinuationToken string    `xml:"NextContinuationToken"`
+	Contents              []*Object `xml:"Contents"`
+	CommonPrefixes        []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// client sends the requests of the S3 API on a bucket.
+type client struct {
+	http      *http.Client
+	endpoint  *url.URL
+	bucket    string
+	pathStyle bool
+
+	// creds is nil when the requests are anonymous.
+	creds *credentials
+}
+
+// url returns the URL of an object, or of the bucket if key is empty.
+func (c *client) url(key string, params map[string]string) *url.URL {
+	u := &url.URL{Scheme: c.endpoint.Scheme, Host: c.endpoint.Host}
+	prefix := strings.TrimSuffix(c.endpoint.Path, "/")
+	if c.pathStyle {
+		prefix += "/" + c.bucket
+	} else {
+		u.Host = c.bucket + "." + u.Host
+	}
+
+	u.Path = prefix + "/" + key
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = encodeQuery(params)
+	return u
+}
+
+// do sends a request with an optional body. The response is an *Error if
+// its status is not a success.
+func (c *client) do(method, key string, params map[string]string, header http.Header, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.url(key, params).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		req.Body = nil
+		req.GetBody = nil
+	}
+	req.ContentLength = int64(len(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	if c.creds != nil {
+		payloadHash := emptySHA256
+		if len(body) > 0 {
+			payloadHash = hashHex(body)
+		}
+		c.creds.sign(req, payloadHash, time.Now())
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		return nil, readError(resp)
+	}
+
+	return resp, nil
+}
+
+// call sends a request and decodes its XML response into v, unless v is
+// nil. Some operations fail after their response status is sent, so an
+// error body is checked for as well.
+func (c *client) call(method, key string, params map[string]string, header http.Header, body []byte, v interface{}) error {
+	resp, err := c.do(method, key, params, header, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(data, []byte("<Error>")) {
+		e := &Error{StatusCode: resp.StatusCode}
+		if xml.Unmarshal(data, e) == nil && e.Code != "" {
+			return e
+		}
+	}
+	if v == nil {
+		return nil
+	}
+
+	return xml.Unmarshal(data, v)
+}
+
+func (c *client) headObject(key string) (*Object, error) {
+	resp, err := c.do(http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	obj := &Object{
+		Key:          key,
+		Size:         resp.ContentLength,
+		ETag:         resp.Header.Get("ETag"),
+		StorageClass: resp.Header.Get("X-Amz-Storage-Class"),
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		obj.LastModified = t
+	}
+
+	return obj, nil
+}
+
+// getObject reads length bytes of an object from offset, or up to its end
+// if length is negative.
+func (c *client) getObject(key string, offset, length int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	if length >= 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	} else if offset > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := c.do(http.MethodGet, key, nil, header, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+func (c *client) putObject(key string, data []byte) error {
+	header := http.Header{"Content-Type": {"application/octet-stream"}}
+	return c.call(http.MethodPut, key, nil, header, data, nil)
+}
+
+func (c *client) copyObject(src, dst string) error {
+	header := http.Header{"X-Amz-Copy-Source": {c.copySource(src)}}
+	return c.call(http.MethodPut, dst, nil, header, nil, nil)
+}
+
+func (c *client) deleteObject(key string) error {
+	return c.call(http.MethodDelete, key, nil, nil, nil, nil)
+}
+
+// deleteObjects deletes up to 1000 objects.
+func (c *client) deleteObjects(keys []string) error {
+	type object struct {
+		Key string `xml:"Key"`
+	}
+	request := struct {
+		XMLName xml.Name `xml:"Delete"`
+		Quiet   bool     `xml:"Quiet"`
+		Objects []object `xml:"Object"`
+	}{Quiet: true}
+	for _, key := range keys {
+		request.Objects = append(request.Objects, object{Key: key})
+	}
+
+	body, err := xml.Marshal(&request)
+	if err != nil {
+		return err
+	}
+	sum := md5.Sum(body)
+	header := http.Header{
+		"Content-Type": {"application/xml"},
+		"Content-Md5":  {base64.StdEncoding.EncodeToString(sum[:])},
+	}
+
+	var result struct {
+		Errors []*Error `xml:"Error"`
+	}
+	err = c.call(http.MethodPost, "", map[string]string{"delete": ""}, header, body, &result)
+	if err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		return result.Errors[0]
+	}
+
+	return nil
+}
+
+// listObjects lists the objects whose key starts with prefix. The keys
+// which contain the delimiter after the prefix are grouped in common
+// prefixes.
+func (c *client) listObjects(prefix, delimiter, token string, maxKeys int) (*listResult, error) {
+	params := map[string]string{"list-type": "2", "prefix": prefix}
+	if delimiter != "" {
+		params["delimiter"] = delimiter
+	}
+	if token != "" {
+		params["continuation-token"] = token
+	}
+	if maxKeys > 0 {
+		params["max-keys"] = strconv.Itoa(maxKeys)
+	}
+
+	result := &listResult{}
+	if err := c.call(http.MethodGet, "", params, nil, nil, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// walk calls fn with every object whose key starts with prefix.
+func (c *client) walk(prefix string, fn func(obj *Object) error) error {
+	token := ""
+	for {
+		result, err := c.listObjects(prefix, "", token, 0)
+		if err != nil {
+			return err
+		}
+		for _, obj := range result.Contents {
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		if !result.IsTruncated {
+			return nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (c *client) createMultipartUpload(key string) (string, error) {
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	header := http.Header{"Content-Type": {"application/octet-stream"}}
+	err := c.call(http.MethodPost, key, map[string]string{"uploads": ""}, header, nil, &result)
+	if err != nil {
+		return "", err
+	}
+
+	return result.UploadID, nil
+}
+
+func (c *client) uploadPart(key, uploadID string, partNumber int, data []byte) (string, error) {
+	params := map[string]string{"partNumber": strconv.Itoa(partNumber), "uploadId": uploadID}
+	resp, err := c.do(http.MethodPut, key, params, nil, data)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	return resp.Header.Get("ETag"), nil
+}
+
+// uploadPartCopy copies the bytes first to last of the source object into a
+// part.
+func (c *client) uploadPartCopy(key, uploadID string, partNumber int, src string, first, last int64) (string, error) {
+	params := map[string]string{"partNumber": strconv.Itoa(partNumber), "uploadId": uploadID}
+	header := http.Header{
+		"X-Amz-Copy-Source":       {c.copySource(src)},
+		"X-Amz-Copy-Source-Range": {fmt.Sprintf("bytes=%d-%d", first, last)},
+	}
+
+	var result struct {
+		ETag string `xml:"ETag"`
+	}
+	if err := c.call(http.MethodPut, key, params, header, nil, &result); err != nil {
+		return "", err
+	}
+
+	return result.ETag, nil
+}
+
+func (c *client) completeMultipartUpload(key, uploadID string, parts []completedPart) error {
+	request := struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{Parts: parts}
+
+	body, err := xml.Marshal(&request)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{"Content-Type": {"application/xml"}}
+	return c.call(http.MethodPost, key, map[string]string{"uploadId": uploadID}, header, body, nil)
+}
+
+func (c *client) abortMultipartUpload(key, uploadID string) error {
+	return c.call(http.MethodDelete, key, map[string]string{"uploadId": uploadID}, nil, nil, nil)
+}
+
+func (c *client) copySource(key string) string {
+	return uriEncode("/"+c.bucket+"/"+key, false)
+}
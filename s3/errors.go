@@ -0,0 +1,82 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+// Error is the error returned by S3 when a request fails.
+type Error struct {
+	Code      string `xml:"Code"`
+	Message   string `xml:"Message"`
+	Resource  string `xml:"Resource"`
+	RequestID string `xml:"RequestId"`
+
+	// StatusCode is the HTTP status of the response.
+	StatusCode int `xml:"-"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return e.Code
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// readError decodes the error of a failed response. The responses to HEAD
+// requests have no body, their code is named after the status.
+func readError(resp *http.Response) error {
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	e := &Error{}
+	if xml.Unmarshal(data, e) != nil || e.Code == "" {
+		e = &Error{Code: http.StatusText(resp.StatusCode)}
+	}
+	e.StatusCode = resp.StatusCode
+
+	return e
+}
+
+func interpretError(err error) error {
+	var s3Err *Error
+	if !errors.As(err, &s3Err) {
+		return err
+	}
+
+	switch s3Err.Code {
+	case "NoSuchKey", "NoSuchBucket", "NoSuchUpload":
+		return os.ErrNotExist
+	case "AccessDenied", "AllAccessDisabled", "InvalidAccessKeyId", "SignatureDoesNotMatch":
+		return os.ErrPermission
+	case "InvalidArgument", "InvalidRange", "EntityTooSmall", "InvalidPart", "InvalidPartOrder":
+		return os.ErrInvalid
+	case "NotImplemented":
+		return extfs.ErrUnsupported
+	}
+
+	switch s3Err.StatusCode {
+	case http.StatusNotFound:
+		return os.ErrNotExist
+	case http.StatusForbidden:
+		return os.ErrPermission
+	default:
+		return err
+	}
+}
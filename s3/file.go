@@ -0,0 +1,207 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package s3
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+var errClosed = errors.New("S3 file already closed")
+
+// file is an object opened either for reading or for writing. A reader
+// sends a ranged GET from its offset on the first read. A writer buffers
+// the data and sends it in the parts of a multipart upload, or in a single
+// PUT if it is smaller than a part.
+type file struct {
+	fs       *s3
+	name     string
+	fullpath string
+	key      string
+	closed   bool
+
+	// Readers
+	reader *util.RangeReader
+
+	// Writers
+	buf      []byte
+	uploadID string
+	parts    []completedPart
+	err      error
+}
+
+func newReader(fs *s3, name, fullpath string, size int64) *file {
+	key := objectKey(fullpath)
+	open := func(off, length int64) (io.ReadCloser, error) {
+		body, err := fs.client.getObject(key, off, length)
+		if err != nil {
+			return nil, &os.PathError{Op: "read", Path: fullpath, Err: interpretError(err)}
+		}
+		return body, nil
+	}
+
+	return &file{fs: fs, name: name, fullpath: fullpath, key: key, reader: &util.RangeReader{Path: fullpath, Size: size, Open: open}}
+}
+
+func newWriter(fs *s3, name, fullpath string) *file {
+	return &file{fs: fs, name: name, fullpath: fullpath, key: objectKey(fullpath)}
+}
+
+func (f *file) Close() error {
+	if f.closed {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: errClosed}
+	}
+	f.closed = true
+
+	if f.reader != nil {
+		return f.reader.Close()
+	}
+
+	if f.err != nil {
+		return f.err
+	}
+	if err := f.flush(); err != nil {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Read(p)
+}
+
+func (f *file) ReadAt(p []byte, off int64) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.ReadAt(p, off)
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrUnsupported
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "seek", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	if f.reader != nil {
+		return 0, extfs.ErrReadOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "write", Path: f.fullpath, Err: errClosed}
+	}
+	if f.err != nil {
+		return 0, f.err
+	}
+
+	f.buf = append(f.buf, p...)
+	for int64(len(f.buf)) >= f.fs.partSize {
+		if err := f.uploadPart(f.buf[:f.fs.partSize]); err != nil {
+			f.abort()
+			f.err = &os.PathError{Op: "write", Path: f.fullpath, Err: interpretError(err)}
+			return 0, f.err
+		}
+		f.buf = append(f.buf[:0], f.buf[f.fs.partSize:]...)
+	}
+
+	return len(p), nil
+}
+
+func (f *file) WriteAt(p []byte, off int64) (int, error) {
+	return 0, extfs.ErrUnsupported
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+func (f *file) Stat() (os.FileInfo, error) {
+	return f.fs.stat(f.fullpath)
+}
+
+func (f *file) Sync() error {
+	if f.reader == nil {
+		return extfs.ErrUnsupported
+	}
+
+	return nil
+}
+
+func (f *file) Truncate(size int64) error {
+	return extfs.ErrUnsupported
+}
+
+// uploadPart uploads a part, starting the multipart upload on the first
+// one.
+func (f *file) uploadPart(data []byte) error {
+	if f.uploadID == "" {
+		uploadID, err := f.fs.client.createMultipartUpload(f.key)
+		if err != nil {
+			return err
+		}
+		f.uploadID = uploadID
+	}
+
+	partNumber := len(f.parts) + 1
+	etag, err := f.fs.client.uploadPart(f.key, f.uploadID, partNumber, data)
+	if err != nil {
+		return err
+	}
+	f.parts = append(f.parts, completedPart{PartNumber: partNumber, ETag: etag})
+
+	return nil
+}
+
+// flush stores the buffered data, which is the whole object unless a
+// multipart upload was started.
+func (f *file) flush() error {
+	if f.uploadID == "" {
+		return f.fs.client.putObject(f.key, f.buf)
+	}
+
+	if len(f.buf) > 0 {
+		if err := f.uploadPart(f.buf); err != nil {
+			f.abort()
+			return err
+		}
+	}
+
+	if err := f.fs.client.completeMultipartUpload(f.key, f.uploadID, f.parts); err != nil {
+		f.abort()
+		return err
+	}
+
+	return nil
+}
+
+func (f *file) abort() {
+	if f.uploadID != "" {
+		f.fs.client.abortMultipartUpload(f.key, f.uploadID)
+		f.uploadID = ""
+	}
+}
@@ -0,0 +1,549 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package s3 implements a filesystem over the S3 API, on AWS or on any S3
+// compatible object store.
+//
+// The directories are emulated with the prefixes of the keys: a directory
+// exists as long as an object is stored under it. MkdirAll stores an empty
+// marker object, whose key ends with a slash, to keep an empty directory.
+// Renames copy and delete the objects, so renaming a directory is neither
+// atomic nor cheap.
+package s3
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+const (
+	defaultRegion   = "us-east-1"
+	defaultPartSize = 5 * 1024 * 1024
+	maxDeleteKeys   = 1000
+)
+
+// maxCopySize is the size above which the objects are copied in parts.
+var maxCopySize int64 = 5 * 1024 * 1024 * 1024
+
+// s3 is a filesystem based on a bucket of the S3 API.
+type s3 struct {
+	client   *client
+	base     string
+	partSize int64
+}
+
+// New returns an S3 filesystem on a bucket. The base directory is the
+// prefix of the keys, without its leading slash.
+func New(bucket, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+	if bucket == "" {
+		return nil, errors.New("S3 bucket name is empty")
+	}
+
+	region := firstNonEmpty(cfg.S3Region, os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"), defaultRegion)
+	endpoint := cfg.S3Endpoint
+	if endpoint == "" {
+		endpoint = "https://s3." + region + ".amazonaws.com"
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("Invalid S3 endpoint " + endpoint)
+	}
+
+	partSize := cfg.S3PartSize
+	if partSize <= 0 {
+		partSize = defaultPartSize
+	}
+
+	var creds *credentials
+	accessKeyID := firstNonEmpty(cfg.S3AccessKeyID, os.Getenv("AWS_ACCESS_KEY_ID"))
+	if accessKeyID != "" {
+		creds = &credentials{
+			accessKeyID:     accessKeyID,
+			secretAccessKey: firstNonEmpty(cfg.S3SecretAccessKey, os.Getenv("AWS_SECRET_ACCESS_KEY")),
+			sessionToken:    firstNonEmpty(cfg.S3SessionToken, os.Getenv("AWS_SESSION_TOKEN")),
+			region:          region,
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       cfg.TLSConfig,
+		ResponseHeaderTimeout: cfg.RPCTimeout,
+	}
+	httpClient := &http.Client{
+		Transport: transport,
+		// The redirections of S3 point to the endpoint of another region,
+		// for which the requests would have to be signed again.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return &s3{
+		client: &client{
+			http:      httpClient,
+			endpoint:  u,
+			bucket:    bucket,
+			pathStyle: cfg.S3PathStyle,
+			creds:     creds,
+		},
+		base:     baseDir,
+		partSize: partSize,
+	}, nil
+}
+
+func (fs *s3) Create(filename string) (extfs.File, error) {
+	return fs.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+func (fs *s3) Open(filename string) (extfs.File, error) {
+	return fs.OpenFile(filename, os.O_RDONLY, 0)
+}
+
+// OpenFile opens an object for reading, or for writing a new content. The
+// objects cannot be appended to, nor written in place, so opening an
+// existing object for writing requires O_TRUNC.
+func (fs *s3) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	accMode := flag & syscall.O_ACCMODE
+	if accMode == os.O_RDWR {
+		return nil, errors.New("S3 file can only be opened as read-only or write-only")
+	}
+
+	fi, err := fs.stat(fullpath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if accMode == os.O_RDONLY {
+		if !exists {
+			return nil, err
+		}
+		if fi.IsDir() {
+			return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+		}
+		return newReader(fs, filename, fullpath, fi.Size()), nil
+	}
+
+	switch {
+	case exists && fi.IsDir():
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+	case exists && flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrExist}
+	case !exists && flag&os.O_CREATE == 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrNotExist}
+	case exists && flag&os.O_APPEND != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: extfs.ErrUnsupported}
+	case exists && flag&os.O_TRUNC == 0:
+		return nil, errors.New("S3 object can only be replaced, open it with O_TRUNC")
+	}
+
+	return newWriter(fs, filename, fullpath), nil
+}
+
+func (fs *s3) Remove(filename string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return err
+	}
+
+	k := objectKey(fullpath)
+	if fi.IsDir() {
+		result, err := fs.client.listObjects(dirKey(k), "", "", 2)
+		if err != nil {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+		}
+		for _, obj := range result.Contents {
+			if obj.Key != dirKey(k) {
+				return &os.PathError{Op: "remove", Path: fullpath, Err: syscall.ENOTEMPTY}
+			}
+		}
+		if k == "" {
+			return nil
+		}
+		k = dirKey(k)
+	}
+
+	if err := fs.client.deleteObject(k); err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return fs.keepParent(fullpath)
+}
+
+func (fs *s3) RemoveAll(path string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	k := objectKey(fullpath)
+	var keys []string
+	if k != "" {
+		keys = append(keys, k)
+	}
+	err = fs.client.walk(dirKey(k), func(obj *Object) error {
+		keys = append(keys, obj.Key)
+		return nil
+	})
+	if err == nil {
+		err = fs.deleteKeys(keys)
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return fs.keepParent(fullpath)
+}
+
+// Rename copies the objects to their new keys, then deletes them.
+func (fs *s3) Rename(oldpath, newpath string) error {
+	var err error
+	oldpath, err = util.UnderlyingPath(fs.base, oldpath)
+	if err != nil {
+		return err
+	}
+
+	newpath, err = util.UnderlyingPath(fs.base, newpath)
+	if err != nil {
+		return err
+	}
+
+	src, err := fs.stat(oldpath)
+	if err != nil {
+		return err
+	}
+	oldKey, newKey := objectKey(oldpath), objectKey(newpath)
+	if oldKey == newKey {
+		return nil
+	}
+	if oldKey == "" || newKey == "" || strings.HasPrefix(newKey, dirKey(oldKey)) {
+		return &os.PathError{Op: "rename", Path: oldpath, Err: os.ErrInvalid}
+	}
+
+	dst, err := fs.stat(newpath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if !src.IsDir() {
+		if dst != nil && dst.IsDir() {
+			return &os.PathError{Op: "rename", Path: newpath, Err: syscall.EISDIR}
+		}
+		if err := fs.copy(oldKey, newKey, src.Size()); err != nil {
+			return &os.PathError{Op: "rename", Path: oldpath, Err: interpretError(err)}
+		}
+		if err := fs.client.deleteObject(oldKey); err != nil {
+			return &os.PathError{Op: "rename", Path: oldpath, Err: interpretError(err)}
+		}
+		return fs.keepParent(oldpath)
+	}
+
+	if dst != nil {
+		if !dst.IsDir() {
+			return &os.PathError{Op: "rename", Path: newpath, Err: syscall.ENOTDIR}
+		}
+		result, err := fs.client.listObjects(dirKey(newKey), "", "", 2)
+		if err != nil {
+			return &os.PathError{Op: "rename", Path: newpath, Err: interpretError(err)}
+		}
+		for _, obj := range result.Contents {
+			if obj.Key != dirKey(newKey) {
+				return &os.PathError{Op: "rename", Path: newpath, Err: syscall.ENOTEMPTY}
+			}
+		}
+	}
+
+	var keys []string
+	err = fs.client.walk(dirKey(oldKey), func(obj *Object) error {
+		target := dirKey(newKey) + strings.TrimPrefix(obj.Key, dirKey(oldKey))
+		if err := fs.copy(obj.Key, target, obj.Size); err != nil {
+			return err
+		}
+		keys = append(keys, obj.Key)
+		return nil
+	})
+	if err == nil {
+		err = fs.deleteKeys(keys)
+	}
+	if err != nil {
+		return &os.PathError{Op: "rename", Path: oldpath, Err: interpretError(err)}
+	}
+
+	return fs.keepParent(oldpath)
+}
+
+func (fs *s3) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.stat(fullpath)
+}
+
+func (fs *s3) ReadDir(path string) ([]os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := dirKey(objectKey(fullpath))
+	found := prefix == ""
+	var fis []os.FileInfo
+	token := ""
+	for {
+		result, err := fs.client.listObjects(prefix, "/", token, 0)
+		if err != nil {
+			return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretError(err)}
+		}
+
+		for _, p := range result.CommonPrefixes {
+			name := strings.TrimSuffix(strings.TrimPrefix(p.Prefix, prefix), "/")
+			fis = append(fis, &fileInfo{name: name, dir: true})
+			found = true
+		}
+		for _, obj := range result.Contents {
+			found = true
+			if obj.Key == prefix {
+				continue
+			}
+			fis = append(fis, &fileInfo{name: strings.TrimPrefix(obj.Key, prefix), obj: obj})
+		}
+
+		if !result.IsTruncated {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+
+	if !found {
+		fi, err := fs.stat(fullpath)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: syscall.ENOTDIR}
+		}
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+// MkdirAll stores a marker object for the directory, unless it already
+// exists. The permissions are ignored.
+func (fs *s3) MkdirAll(path string, perm os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	k := objectKey(fullpath)
+	if k == "" {
+		return nil
+	}
+
+	// None of the parents can be a file.
+	names := strings.Split(k, "/")
+	for i := range names {
+		_, err := fs.client.headObject(strings.Join(names[:i+1], "/"))
+		if err == nil {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: syscall.ENOTDIR}
+		}
+		if !os.IsNotExist(interpretError(err)) {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+		}
+	}
+
+	if fi, err := fs.stat(fullpath); err == nil && fi.IsDir() {
+		return nil
+	}
+
+	if err := fs.client.putObject(dirKey(k), nil); err != nil {
+		return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *s3) Chmod(name string, mode os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	return &os.PathError{Op: "chmod", Path: fullpath, Err: extfs.ErrUnsupported}
+}
+
+func (fs *s3) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	return &os.PathError{Op: "chtimes", Path: fullpath, Err: extfs.ErrUnsupported}
+}
+
+func (fs *s3) Close() error {
+	fs.client.http.CloseIdleConnections()
+	return nil
+}
+
+// stat returns the object of a file. A directory is found by listing the
+// keys starting with its prefix.
+func (fs *s3) stat(fullpath string) (os.FileInfo, error) {
+	k := objectKey(fullpath)
+	if k == "" {
+		return &fileInfo{name: "/", dir: true}, nil
+	}
+
+	obj, err := fs.client.headObject(k)
+	if err == nil {
+		return &fileInfo{name: path.Base(k), obj: obj}, nil
+	}
+	if err = interpretError(err); !os.IsNotExist(err) {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: err}
+	}
+
+	result, err := fs.client.listObjects(dirKey(k), "", "", 1)
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretError(err)}
+	}
+	if len(result.Contents) == 0 {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: os.ErrNotExist}
+	}
+
+	fi := &fileInfo{name: path.Base(k), dir: true}
+	if marker := result.Contents[0]; marker.Key == dirKey(k) {
+		fi.obj = marker
+	}
+
+	return fi, nil
+}
+
+// copy copies an object of the given size, in parts if it is too large to
+// be copied at once.
+func (fs *s3) copy(src, dst string, size int64) error {
+	if size <= maxCopySize {
+		return fs.client.copyObject(src, dst)
+	}
+
+	uploadID, err := fs.client.createMultipartUpload(dst)
+	if err != nil {
+		return err
+	}
+
+	var parts []completedPart
+	for first := int64(0); first < size; first += maxCopySize {
+		last := first + maxCopySize - 1
+		if last >= size {
+			last = size - 1
+		}
+
+		etag, err := fs.client.uploadPartCopy(dst, uploadID, len(parts)+1, src, first, last)
+		if err != nil {
+			fs.client.abortMultipartUpload(dst, uploadID)
+			return err
+		}
+		parts = append(parts, completedPart{PartNumber: len(parts) + 1, ETag: etag})
+	}
+
+	if err := fs.client.completeMultipartUpload(dst, uploadID, parts); err != nil {
+		fs.client.abortMultipartUpload(dst, uploadID)
+		return err
+	}
+
+	return nil
+}
+
+func (fs *s3) deleteKeys(keys []string) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxDeleteKeys {
+			n = maxDeleteKeys
+		}
+		if err := fs.client.deleteObjects(keys[:n]); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+
+	return nil
+}
+
+// keepParent stores a marker for the parent directory of a removed path if
+// it has no object left, so that the directory still exists.
+func (fs *s3) keepParent(fullpath string) error {
+	parent := objectKey(filepath.Dir(fullpath))
+	if parent == "" {
+		return nil
+	}
+
+	result, err := fs.client.listObjects(dirKey(parent), "", "", 1)
+	if err == nil && len(result.Contents) == 0 {
+		err = fs.client.putObject(dirKey(parent), nil)
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+// objectKey returns the key of a path, which is empty for the root.
+func objectKey(fullpath string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(fullpath)), "/")
+}
+
+// dirKey returns the prefix of the keys of a directory.
+func dirKey(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	return key + "/"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
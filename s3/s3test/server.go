@@ -0,0 +1,720 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package s3test provides an in-process S3 server for the tests. It keeps
+// the buckets in memory and implements the object, listing and multipart
+// upload operations used by the s3 package, with path-style addressing.
+// When it has credentials it checks the signature of the requests.
+package s3test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	minPartSize = 5 * 1024 * 1024
+	maxKeys     = 1000
+	xmlns       = "http://s3.amazonaws.com/doc/2006-03-01/"
+)
+
+type object struct {
+	data    []byte
+	modTime time.Time
+	etag    string
+}
+
+type upload struct {
+	bucket string
+	key    string
+	parts  map[int]*object
+}
+
+// Server is an in-process S3 server.
+type Server struct {
+	srv *httptest.Server
+
+	accessKeyID     string
+	secretAccessKey string
+
+	mu       sync.Mutex
+	buckets  map[string]map[string]*object
+	uploads  map[string]*upload
+	nextID   int
+	requests map[string]int
+}
+
+// NewServer starts an S3 server. If accessKeyID is not empty, the requests
+// must be signed with these credentials.
+func NewServer(accessKeyID, secretAccessKey string) (*Server, error) {
+	s := &Server{
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		buckets:         make(map[string]map[string]*object),
+		uploads:         make(map[string]*upload),
+		requests:        make(map[string]int),
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s, nil
+}
+
+// URL returns the endpoint of the server, such as http://127.0.0.1:9000.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// CreateBucket creates an empty bucket.
+func (s *Server) CreateBucket(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.buckets[name] == nil {
+		s.buckets[name] = make(map[string]*object)
+	}
+}
+
+// Keys returns the sorted keys of a bucket.
+func (s *Server) Keys(bucket string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.buckets[bucket]))
+	for k := range s.buckets[bucket] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Requests returns the number of requests served for an operation, named
+// like in the S3 API, such as GetObject or UploadPart.
+func (s *Server) Requests(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[operation]
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	s.srv.Close()
+	return nil
+}
+
+// s3Error is an error response of S3.
+type s3Error struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *s3Error) Error() string {
+	return e.code + ": " + e.message
+}
+
+var (
+	errNoSuchBucket = &s3Error{http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist"}
+	errNoSuchKey    = &s3Error{http.StatusNotFound, "NoSuchKey", "The specified key does not exist."}
+	errNoSuchUpload = &s3Error{http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist."}
+)
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, &s3Error{http.StatusBadRequest, "IncompleteBody", err.Error()})
+		return
+	}
+	if err := s.authenticate(r, body); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Path-style addressing: /bucket/key
+	p := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key := p, ""
+	if i := strings.Index(p, "/"); i >= 0 {
+		bucket, key = p[:i], p[i+1:]
+	}
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objects := s.buckets[bucket]
+	if objects == nil {
+		writeError(w, errNoSuchBucket)
+		return
+	}
+
+	var op string
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		op, err = "ListObjectsV2", s.listObjects(w, objects, query)
+	case key == "" && r.Method == http.MethodPost && query.Has("delete"):
+		op, err = "DeleteObjects", s.deleteObjects(w, r, objects, body)
+	case r.Method == http.MethodHead:
+		op, err = "HeadObject", s.getObject(w, r, objects, key, false)
+	case r.Method == http.MethodGet:
+		op, err = "GetObject", s.getObject(w, r, objects, key, true)
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		op, err = "CreateMultipartUpload", s.createMultipartUpload(w, bucket, key)
+	case r.Method == http.MethodPut && query.Has("uploadId") && r.Header.Get("X-Amz-Copy-Source") != "":
+		op, err = "UploadPartCopy", s.uploadPartCopy(w, r, bucket, key, query)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		op, err = "UploadPart", s.uploadPart(w, bucket, key, query, body)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		op, err = "CompleteMultipartUpload", s.completeMultipartUpload(w, objects, bucket, key, query, body)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		op, err = "AbortMultipartUpload", s.abortMultipartUpload(w, bucket, key, query)
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		op, err = "CopyObject", s.copyObject(w, r, objects, key)
+	case r.Method == http.MethodPut:
+		op = "PutObject"
+		objects[key] = newObject(body)
+		w.Header().Set("ETag", objects[key].etag)
+	case r.Method == http.MethodDelete:
+		op = "DeleteObject"
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		err = &s3Error{http.StatusNotImplemented, "NotImplemented", "A header you provided implies functionality that is not implemented"}
+	}
+
+	if op != "" {
+		s.requests[op]++
+	}
+	if err != nil {
+		writeError(w, err)
+	}
+}
+
+func newObject(data []byte) *object {
+	sum := md5.Sum(data)
+	return &object{
+		data:    data,
+		modTime: time.Now().UTC().Truncate(time.Second),
+		etag:    `"` + hex.EncodeToString(sum[:]) + `"`,
+	}
+}
+
+type listEntry struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int    `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, objects map[string]*object, query url.Values) error {
+	if query.Get("list-type") != "2" {
+		return &s3Error{http.StatusNotImplemented, "NotImplemented", "Only ListObjectsV2 is implemented"}
+	}
+
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	limit := maxKeys
+	if v := query.Get("max-keys"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return &s3Error{http.StatusBadRequest, "InvalidArgument", "Invalid max-keys"}
+		}
+		if n < limit {
+			limit = n
+		}
+	}
+	after := ""
+	if token := query.Get("continuation-token"); token != "" {
+		data, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			return &s3Error{http.StatusBadRequest, "InvalidArgument", "The continuation token provided is incorrect"}
+		}
+		after = string(data)
+	}
+
+	keys := make([]string, 0, len(objects))
+	for k := range objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	result := struct {
+		XMLName               xml.Name       `xml:"ListBucketResult"`
+		Xmlns                 string         `xml:"xmlns,attr"`
+		Prefix                string         `xml:"Prefix"`
+		Delimiter             string         `xml:"Delimiter,omitempty"`
+		KeyCount              int            `xml:"KeyCount"`
+		MaxKeys               int            `xml:"MaxKeys"`
+		IsTruncated           bool           `xml:"IsTruncated"`
+		NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+		Contents              []listEntry    `xml:"Contents"`
+		CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+	}{Xmlns: xmlns, Prefix: prefix, Delimiter: delimiter, MaxKeys: limit}
+
+	last := ""
+	for _, k := range keys {
+		// The keys grouped in a common prefix are returned as the prefix.
+		entry, grouped := k, false
+		if delimiter != "" {
+			if i := strings.Index(k[len(prefix):], delimiter); i >= 0 {
+				entry, grouped = k[:len(prefix)+i+len(delimiter)], true
+			}
+		}
+		if entry <= after || entry == last {
+			continue
+		}
+		if result.KeyCount == limit {
+			result.IsTruncated = true
+			result.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(last))
+			break
+		}
+
+		if grouped {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{entry})
+		} else {
+			obj := objects[k]
+			result.Contents = append(result.Contents, listEntry{
+				Key:          k,
+				LastModified: obj.modTime.Format("2006-01-02T15:04:05.000Z"),
+				ETag:         obj.etag,
+				Size:         len(obj.data),
+				StorageClass: "STANDARD",
+			})
+		}
+		result.KeyCount++
+		last = entry
+	}
+
+	writeXML(w, http.StatusOK, &result)
+	return nil
+}
+
+func (s *Server) deleteObjects(w http.ResponseWriter, r *http.Request, objects map[string]*object, body []byte) error {
+	sum := md5.Sum(body)
+	if r.Header.Get("Content-Md5") != base64.StdEncoding.EncodeToString(sum[:]) {
+		return &s3Error{http.StatusBadRequest, "InvalidDigest", "The Content-MD5 you specified was invalid."}
+	}
+
+	var request struct {
+		Quiet   bool `xml:"Quiet"`
+		Objects []struct {
+			Key string `xml:"Key"`
+		} `xml:"Object"`
+	}
+	if err := xml.Unmarshal(body, &request); err != nil {
+		return &s3Error{http.StatusBadRequest, "MalformedXML", err.Error()}
+	}
+	if len(request.Objects) > maxKeys {
+		return &s3Error{http.StatusBadRequest, "MalformedXML", "Too many objects"}
+	}
+
+	result := struct {
+		XMLName xml.Name `xml:"DeleteResult"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Deleted []struct {
+			Key string `xml:"Key"`
+		} `xml:"Deleted"`
+	}{Xmlns: xmlns}
+	for _, obj := range request.Objects {
+		delete(objects, obj.Key)
+		if !request.Quiet {
+			result.Deleted = append(result.Deleted, struct {
+				Key string `xml:"Key"`
+			}{obj.Key})
+		}
+	}
+
+	writeXML(w, http.StatusOK, &result)
+	return nil
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *http.Request, objects map[string]*object, key string, withBody bool) error {
+	obj := objects[key]
+	if obj == nil {
+		return errNoSuchKey
+	}
+
+	data := obj.data
+	status := http.StatusOK
+	if rng := r.Header.Get("Range"); rng != "" {
+		first, last, err := parseRange(rng, int64(len(obj.data)))
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", first, last, len(obj.data)))
+		data = obj.data[first : last+1]
+		status = http.StatusPartialContent
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("ETag", obj.etag)
+	w.Header().Set("Last-Modified", obj.modTime.Format(http.TimeFormat))
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.WriteHeader(status)
+	if withBody {
+		w.Write(data)
+	}
+
+	return nil
+}
+
+// parseRange parses a range of the form bytes=first-last or bytes=first-.
+func parseRange(rng string, size int64) (int64, int64, error) {
+	invalid := &s3Error{http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The requested range is not satisfiable"}
+
+	spec := strings.TrimPrefix(rng, "bytes=")
+	i := strings.Index(spec, "-")
+	if spec == rng || i < 0 {
+		return 0, 0, invalid
+	}
+
+	first, err := strconv.ParseInt(spec[:i], 10, 64)
+	if err != nil || first >= size {
+		return 0, 0, invalid
+	}
+	last := size - 1
+	if spec[i+1:] != "" {
+		last, err = strconv.ParseInt(spec[i+1:], 10, 64)
+		if err != nil || last < first {
+			return 0, 0, invalid
+		}
+		if last >= size {
+			last = size - 1
+		}
+	}
+
+	return first, last, nil
+}
+
+// source returns the object named by the copy source header.
+func (s *Server) source(r *http.Request) (*object, error) {
+	src, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		return nil, &s3Error{http.StatusBadRequest, "InvalidArgument", "Invalid copy source"}
+	}
+
+	src = strings.TrimPrefix(src, "/")
+	i := strings.Index(src, "/")
+	if i < 0 {
+		return nil, &s3Error{http.StatusBadRequest, "InvalidArgument", "Invalid copy source"}
+	}
+	objects := s.buckets[src[:i]]
+	if objects == nil {
+		return nil, errNoSuchBucket
+	}
+	obj := objects[src[i+1:]]
+	if obj == nil {
+		return nil, errNoSuchKey
+	}
+
+	return obj, nil
+}
+
+func (s *Server) copyObject(w http.ResponseWriter, r *http.Request, objects map[string]*object, key string) error {
+	src, err := s.source(r)
+	if err != nil {
+		return err
+	}
+
+	obj := newObject(append([]byte(nil), src.data...))
+	objects[key] = obj
+	writeXML(w, http.StatusOK, &struct {
+		XMLName      xml.Name `xml:"CopyObjectResult"`
+		LastModified string   `xml:"LastModified"`
+		ETag         string   `xml:"ETag"`
+	}{LastModified: obj.modTime.Format("2006-01-02T15:04:05.000Z"), ETag: obj.etag})
+
+	return nil
+}
+
+func (s *Server) createMultipartUpload(w http.ResponseWriter, bucket, key string) error {
+	s.nextID++
+	uploadID := fmt.Sprintf("upload-%d", s.nextID)
+	s.uploads[uploadID] = &upload{bucket: bucket, key: key, parts: make(map[int]*object)}
+
+	writeXML(w, http.StatusOK, &struct {
+		XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+		Xmlns    string   `xml:"xmlns,attr"`
+		Bucket   string   `xml:"Bucket"`
+		Key      string   `xml:"Key"`
+		UploadID string   `xml:"UploadId"`
+	}{Xmlns: xmlns, Bucket: bucket, Key: key, UploadID: uploadID})
+
+	return nil
+}
+
+// part returns the upload and the part number of a part request.
+func (s *Server) part(bucket, key string, query url.Values) (*upload, int, error) {
+	u := s.uploads[query.Get("uploadId")]
+	if u == nil || u.bucket != bucket || u.key != key {
+		return nil, 0, errNoSuchUpload
+	}
+
+	partNumber, err := strconv.Atoi(query.Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > 10000 {
+		return nil, 0, &s3Error{http.StatusBadRequest, "InvalidArgument", "Part number must be an integer between 1 and 10000, inclusive"}
+	}
+
+	return u, partNumber, nil
+}
+
+func (s *Server) uploadPart(w http.ResponseWriter, bucket, key string, query url.Values, body []byte) error {
+	u, partNumber, err := s.part(bucket, key, query)
+	if err != nil {
+		return err
+	}
+
+	part := newObject(body)
+	u.parts[partNumber] = part
+	w.Header().Set("ETag", part.etag)
+
+	return nil
+}
+
+func (s *Server) uploadPartCopy(w http.ResponseWriter, r *http.Request, bucket, key string, query url.Values) error {
+	u, partNumber, err := s.part(bucket, key, query)
+	if err != nil {
+		return err
+	}
+	src, err := s.source(r)
+	if err != nil {
+		return err
+	}
+
+	data := src.data
+	if rng := r.Header.Get("X-Amz-Copy-Source-Range"); rng != "" {
+		first, last, err := parseRange(rng, int64(len(src.data)))
+		if err != nil {
+			return err
+		}
+		data = src.data[first : last+1]
+	}
+
+	part := newObject(append([]byte(nil), data...))
+	u.parts[partNumber] = part
+	writeXML(w, http.StatusOK, &struct {
+		XMLName      xml.Name `xml:"CopyPartResult"`
+		LastModified string   `xml:"LastModified"`
+		ETag         string   `xml:"ETag"`
+	}{LastModified: part.modTime.Format("2006-01-02T15:04:05.000Z"), ETag: part.etag})
+
+	return nil
+}
+
+func (s *Server) completeMultipartUpload(w http.ResponseWriter, objects map[string]*object, bucket, key string, query url.Values, body []byte) error {
+	uploadID := query.Get("uploadId")
+	u := s.uploads[uploadID]
+	if u == nil || u.bucket != bucket || u.key != key {
+		return errNoSuchUpload
+	}
+
+	var request struct {
+		Parts []struct {
+			PartNumber int    `xml:"PartNumber"`
+			ETag       string `xml:"ETag"`
+		} `xml:"Part"`
+	}
+	if err := xml.Unmarshal(body, &request); err != nil || len(request.Parts) == 0 {
+		return &s3Error{http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed"}
+	}
+
+	var data []byte
+	var md5s []byte
+	for i, p := range request.Parts {
+		if i > 0 && p.PartNumber <= request.Parts[i-1].PartNumber {
+			return &s3Error{http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order."}
+		}
+		part := u.parts[p.PartNumber]
+		if part == nil || part.etag != p.ETag {
+			return &s3Error{http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found."}
+		}
+		if i < len(request.Parts)-1 && len(part.data) < minPartSize {
+			return &s3Error{http.StatusBadRequest, "EntityTooSmall", "Your proposed upload is smaller than the minimum allowed object size."}
+		}
+
+		data = append(data, part.data...)
+		sum, _ := hex.DecodeString(strings.Trim(part.etag, `"`))
+		md5s = append(md5s, sum...)
+	}
+
+	obj := newObject(data)
+	sum := md5.Sum(md5s)
+	obj.etag = fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(sum[:]), len(request.Parts))
+	objects[key] = obj
+	delete(s.uploads, uploadID)
+
+	writeXML(w, http.StatusOK, &struct {
+		XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Bucket  string   `xml:"Bucket"`
+		Key     string   `xml:"Key"`
+		ETag    string   `xml:"ETag"`
+	}{Xmlns: xmlns, Bucket: bucket, Key: key, ETag: obj.etag})
+
+	return nil
+}
+
+func (s *Server) abortMultipartUpload(w http.ResponseWriter, bucket, key string, query url.Values) error {
+	uploadID := query.Get("uploadId")
+	u := s.uploads[uploadID]
+	if u == nil || u.bucket != bucket || u.key != key {
+		return errNoSuchUpload
+	}
+
+	delete(s.uploads, uploadID)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// authenticate checks the AWS signature version 4 of a request.
+func (s *Server) authenticate(r *http.Request, body []byte) error {
+	if s.accessKeyID == "" {
+		return nil
+	}
+
+	denied := func(message string) error {
+		return &s3Error{http.StatusForbidden, "AccessDenied", message}
+	}
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 ") {
+		return denied("Access Denied")
+	}
+	fields := make(map[string]string)
+	for _, field := range strings.Split(strings.TrimPrefix(auth, "AWS4-HMAC-SHA256 "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		if len(kv) == 2 {
+			fields[kv[0]] = kv[1]
+		}
+	}
+
+	scope := strings.SplitN(fields["Credential"], "/", 2)
+	if len(scope) != 2 || scope[0] != s.accessKeyID {
+		return &s3Error{http.StatusForbidden, "InvalidAccessKeyId", "The AWS Access Key Id you provided does not exist in our records."}
+	}
+	scopeParts := strings.Split(scope[1], "/")
+	if len(scopeParts) != 4 {
+		return denied("Invalid credential scope")
+	}
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	sum := sha256.Sum256(body)
+	if payloadHash != "UNSIGNED-PAYLOAD" && payloadHash != hex.EncodeToString(sum[:]) {
+		return &s3Error{http.StatusBadRequest, "XAmzContentSHA256Mismatch", "The provided 'x-amz-content-sha256' header does not match what was computed."}
+	}
+
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	uri := strings.SplitN(r.RequestURI, "?", 2)[0]
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		uri,
+		canonicalQuery(r.URL.Query()),
+		canonicalHeaders.String(),
+		fields["SignedHeaders"],
+		payloadHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		r.Header.Get("X-Amz-Date"),
+		scope[1],
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + s.secretAccessKey)
+	for _, part := range scopeParts {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	if !hmac.Equal([]byte(signature), []byte(fields["Signature"])) {
+		return &s3Error{http.StatusForbidden, "SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided."}
+	}
+
+	return nil
+}
+
+func canonicalQuery(query url.Values) string {
+	var params []string
+	for k, values := range query {
+		for _, v := range values {
+			params = append(params, awsEscape(k)+"="+awsEscape(v))
+		}
+	}
+	sort.Strings(params)
+
+	return strings.Join(params, "&")
+}
+
+// awsEscape escapes every byte except the unreserved characters.
+func awsEscape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*s3Error)
+	if !ok {
+		e = &s3Error{http.StatusInternalServerError, "InternalError", err.Error()}
+	}
+
+	var buf bytes.Buffer
+	xml.NewEncoder(&buf).Encode(&struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}{Code: e.code, Message: e.message})
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(e.status)
+	w.Write(buf.Bytes())
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	xml.NewEncoder(&buf).Encode(v)
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
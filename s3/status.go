@@ -0,0 +1,56 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package s3
+
+import (
+	"os"
+	"time"
+)
+
+// fileInfo describes an object, or a directory emulated by a prefix. The
+// directories without a marker have no object.
+type fileInfo struct {
+	name string
+	dir  bool
+	obj  *Object
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	if fi.dir || fi.obj == nil {
+		return 0
+	}
+
+	return fi.obj.Size
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	if fi.dir {
+		return os.ModeDir | 0755
+	}
+
+	return 0644
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	if fi.obj == nil {
+		return time.Time{}
+	}
+
+	return fi.obj.LastModified
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.dir
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return fi.obj
+}
@@ -0,0 +1,59 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package s3
+
+import (
+	"os"
+	"path"
+	"strings"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Usage lists the objects under a path. The directories are counted from
+// the prefixes of their keys.
+func (fs *s3) Usage(name string) (*extfs.ContentSummary, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return &extfs.ContentSummary{Length: fi.Size(), FileCount: 1, SpaceConsumed: fi.Size()}, nil
+	}
+
+	prefix := dirKey(objectKey(fullpath))
+	cs := &extfs.ContentSummary{DirectoryCount: 1}
+	dirs := make(map[string]bool)
+	err = fs.client.walk(prefix, func(obj *Object) error {
+		rel := strings.TrimPrefix(obj.Key, prefix)
+		if strings.HasSuffix(rel, "/") {
+			rel = strings.TrimSuffix(rel, "/")
+		} else {
+			cs.FileCount++
+			cs.Length += obj.Size
+			rel = path.Dir(rel)
+		}
+
+		for ; rel != "." && rel != "" && !dirs[rel]; rel = path.Dir(rel) {
+			dirs[rel] = true
+			cs.DirectoryCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, &os.PathError{Op: "usage", Path: fullpath, Err: interpretError(err)}
+	}
+	cs.SpaceConsumed = cs.Length
+
+	return cs, nil
+}
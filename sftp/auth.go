@@ -0,0 +1,89 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sftp
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/rkcloudchain/extfs"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+var errNoAuthMethod = errors.New("SFTP needs a private key or a password")
+
+// authMethods returns the methods the client authenticates with, the
+// private key first. The password is also answered to the
+// keyboard-interactive prompts, which the servers using PAM ask instead.
+func authMethods(cfg *extfs.Config) ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
+
+	key := cfg.SSHPrivateKey
+	if key == nil && cfg.SSHPrivateKeyFile != "" {
+		var err error
+		key, err = ioutil.ReadFile(cfg.SSHPrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if key != nil {
+		signer, err := parsePrivateKey(key, cfg.SSHPrivateKeyPassphrase)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	if cfg.Password != "" {
+		password := cfg.Password
+		methods = append(methods,
+			ssh.Password(password),
+			ssh.KeyboardInteractive(func(user, instruction string, questions []string, echos []bool) ([]string, error) {
+				answers := make([]string, len(questions))
+				for i := range answers {
+					answers[i] = password
+				}
+				return answers, nil
+			}))
+	}
+
+	if len(methods) == 0 {
+		return nil, errNoAuthMethod
+	}
+
+	return methods, nil
+}
+
+func parsePrivateKey(key []byte, passphrase string) (ssh.Signer, error) {
+	if passphrase != "" {
+		return ssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+	}
+
+	return ssh.ParsePrivateKey(key)
+}
+
+// hostKeyCallback returns the verification of the host key of the server
+// against the known_hosts file.
+func hostKeyCallback(cfg *extfs.Config) (ssh.HostKeyCallback, error) {
+	if cfg.SSHInsecureIgnoreHostKey {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	file := cfg.SSHKnownHostsFile
+	if file == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		file = filepath.Join(home, ".ssh", "known_hosts")
+	}
+
+	return knownhosts.New(file)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sftp
+
+import (
+	"errors"
+	"os"
+
+	"github.com/pkg/sftp"
+	"github.com/rkcloudchain/extfs"
+)
+
+var errNotDir = errors.New("not a directory")
+
+// interpretError maps the status codes of the SFTP protocol to the errors
+// of the os package. The client already maps the missing files and the
+// denied permissions, the other failures are left as they are.
+func interpretError(err error) error {
+	var statusErr *sftp.StatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+
+	switch statusErr.FxCode() {
+	case sftp.ErrSSHFxNoSuchFile:
+		return os.ErrNotExist
+	case sftp.ErrSSHFxPermissionDenied:
+		return os.ErrPermission
+	case sftp.ErrSSHFxOpUnsupported:
+		return extfs.ErrUnsupported
+	default:
+		return err
+	}
+}
@@ -0,0 +1,29 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sftp
+
+import (
+	"github.com/pkg/sftp"
+)
+
+// file is a remote file. The SFTP protocol supports random access, so every
+// operation of extfs.File is forwarded to the server.
+type file struct {
+	*sftp.File
+
+	name string
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+// Sync requires the fsync extension of OpenSSH, it returns
+// extfs.ErrUnsupported on the other servers.
+func (f *file) Sync() error {
+	return interpretError(f.File.Sync())
+}
@@ -0,0 +1,387 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package sftp implements a filesystem over the SSH File Transfer Protocol.
+package sftp
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/user"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	defaultPort = "22"
+
+	posixRenameExtension = "posix-rename@openssh.com"
+	statVFSExtension     = "statvfs@openssh.com"
+)
+
+// sshfs is a filesystem based on a SFTP server.
+type sshfs struct {
+	conn   *ssh.Client
+	client *sftp.Client
+	base   string
+}
+
+// New returns a SFTP filesystem. The address is the host of the SSH server
+// with an optional port, which defaults to 22. The client authenticates as
+// the user of the configuration with its private key or its password, and
+// verifies the host key of the server against the known_hosts file.
+func New(addr, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, defaultPort)
+	}
+
+	name := cfg.User
+	if name == "" {
+		current, err := user.Current()
+		if err != nil {
+			name = "root"
+		} else {
+			name = current.Username
+		}
+	}
+
+	auth, err := authMethods(cfg)
+	if err != nil {
+		return nil, err
+	}
+	hostKeyCallback, err := hostKeyCallback(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            name,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         cfg.DialTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &sshfs{conn: conn, client: client, base: baseDir}, nil
+}
+
+func (fs *sshfs) Create(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0)
+}
+
+func (fs *sshfs) Open(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, os.O_RDONLY, 0)
+}
+
+// OpenFile opens a file with the given flags. The permission is applied to
+// the files it creates, which otherwise get the default mode of the server.
+func (fs *sshfs) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, flag, perm)
+}
+
+func (fs *sshfs) Remove(filename string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return err
+	}
+
+	if err := fs.client.Remove(fullpath); err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *sshfs) RemoveAll(path string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	fi, err := fs.client.Lstat(fullpath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return fs.removeAll(fullpath, fi)
+}
+
+// Rename renames a file with the posix-rename extension of OpenSSH when the
+// server supports it, and with the rename of the SFTP protocol otherwise.
+func (fs *sshfs) Rename(oldpath, newpath string) error {
+	var err error
+	oldpath, err = util.UnderlyingPath(fs.base, oldpath)
+	if err != nil {
+		return err
+	}
+
+	newpath, err = util.UnderlyingPath(fs.base, newpath)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := fs.client.HasExtension(posixRenameExtension); ok {
+		err = fs.client.PosixRename(oldpath, newpath)
+	} else {
+		err = fs.replace(oldpath, newpath)
+	}
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+// replace renames oldpath to newpath with the rename of the SFTP protocol,
+// which fails if newpath exists. When a file replaces another one, the
+// existing file is moved aside to a temporary name, moved back if the rename
+// still fails, and deleted once it has been replaced.
+func (fs *sshfs) replace(oldpath, newpath string) error {
+	err := fs.client.Rename(oldpath, newpath)
+	if err == nil {
+		return nil
+	}
+	if fi, lerr := fs.client.Lstat(oldpath); lerr != nil || fi.IsDir() {
+		return err
+	}
+	if fi, lerr := fs.client.Lstat(newpath); lerr != nil || fi.IsDir() {
+		return err
+	}
+
+	tmp := path.Join(path.Dir(newpath), fmt.Sprintf(".%s.%d", path.Base(newpath), time.Now().UnixNano()))
+	if err := fs.client.Rename(newpath, tmp); err != nil {
+		return err
+	}
+	if err := fs.client.Rename(oldpath, newpath); err != nil {
+		fs.client.Rename(tmp, newpath)
+		return err
+	}
+
+	// The rename is done, a copy of the replaced file left behind is not
+	// an error.
+	fs.client.Remove(tmp)
+	return nil
+}
+
+func (fs *sshfs) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := fs.client.Stat(fullpath)
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return fi, nil
+}
+
+func (fs *sshfs) ReadDir(path string) ([]os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	fis, err := fs.client.ReadDir(fullpath)
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretError(err)}
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+func (fs *sshfs) MkdirAll(path string, perm os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	return fs.mkdirAll(fullpath, perm)
+}
+
+func (fs *sshfs) Chmod(name string, mode os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	if err := fs.client.Chmod(fullpath, mode); err != nil {
+		return &os.PathError{Op: "chmod", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *sshfs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	if err := fs.client.Chtimes(fullpath, atime, mtime); err != nil {
+		return &os.PathError{Op: "chtimes", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *sshfs) Close() error {
+	err := fs.client.Close()
+	if cerr := fs.conn.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+func (fs *sshfs) openFile(name, fullpath string, flag int, perm os.FileMode) (extfs.File, error) {
+	// The SFTP servers neither create the missing parents nor apply the
+	// mode of the open request, so the file is checked for beforehand.
+	created := false
+	if flag&os.O_CREATE != 0 {
+		_, err := fs.client.Lstat(fullpath)
+		if err == nil && flag&os.O_EXCL != 0 {
+			return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrExist}
+		}
+		if err != nil && !os.IsNotExist(err) {
+			return nil, &os.PathError{Op: "open", Path: fullpath, Err: interpretError(err)}
+		}
+		if err != nil {
+			created = true
+			if err := fs.mkdirAll(path.Dir(fullpath), 0); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	f, err := fs.client.OpenFile(fullpath, flag)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: interpretError(err)}
+	}
+
+	if created && perm != 0 {
+		if err := f.Chmod(perm); err != nil {
+			f.Close()
+			return nil, &os.PathError{Op: "chmod", Path: fullpath, Err: interpretError(err)}
+		}
+	}
+
+	// The servers do not have to honor the append flag, the writes are sent
+	// from the end of the file instead.
+	if flag&os.O_APPEND != 0 {
+		if _, err := f.Seek(0, io.SeekEnd); err != nil {
+			f.Close()
+			return nil, &os.PathError{Op: "seek", Path: fullpath, Err: interpretError(err)}
+		}
+	}
+
+	return &file{File: f, name: name}, nil
+}
+
+// mkdirAll creates a directory and its missing parents, and sets the
+// permission of the ones it creates unless perm is zero.
+func (fs *sshfs) mkdirAll(fullpath string, perm os.FileMode) error {
+	fi, err := fs.client.Stat(fullpath)
+	if err == nil {
+		if !fi.IsDir() {
+			return &os.PathError{Op: "mkdir", Path: fullpath, Err: errNotDir}
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+	}
+
+	if parent := path.Dir(fullpath); parent != fullpath {
+		if err := fs.mkdirAll(parent, perm); err != nil {
+			return err
+		}
+	}
+
+	if err := fs.client.Mkdir(fullpath); err != nil {
+		// Another client may have created it in the meantime.
+		if fi, serr := fs.client.Stat(fullpath); serr == nil && fi.IsDir() {
+			return nil
+		}
+		return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+	}
+	if perm != 0 {
+		if err := fs.client.Chmod(fullpath, perm); err != nil {
+			return &os.PathError{Op: "chmod", Path: fullpath, Err: interpretError(err)}
+		}
+	}
+
+	return nil
+}
+
+// removeAll removes a file or a directory tree without following the
+// symbolic links.
+func (fs *sshfs) removeAll(fullpath string, fi os.FileInfo) error {
+	if fi.IsDir() {
+		fis, err := fs.client.ReadDir(fullpath)
+		if err != nil {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+		}
+		for _, child := range fis {
+			if err := fs.removeAll(path.Join(fullpath, child.Name()), child); err != nil {
+				return err
+			}
+		}
+
+		err = fs.client.RemoveDirectory(fullpath)
+		if err != nil && !os.IsNotExist(err) {
+			return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+		}
+		return nil
+	}
+
+	err := fs.client.Remove(fullpath)
+	if err != nil && !os.IsNotExist(err) {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sftp
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/extfstest"
+	"github.com/rkcloudchain/extfs/sftp/sftptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testUser     = "cloudchain"
+	testPassword = "secret"
+)
+
+var (
+	server     *sftptest.Server
+	privateKey []byte
+	knownHosts string
+)
+
+func TestMain(m *testing.M) {
+	extfstest.Main(m, startServer)
+}
+
+func startServer() (func(), error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	privateKey = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err = sftptest.NewServer(testUser, testPassword, signer.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := ioutil.TempFile("", "known_hosts")
+	if err != nil {
+		server.Close()
+		return nil, err
+	}
+	fmt.Fprintln(f, server.KnownHosts())
+	f.Close()
+	knownHosts = f.Name()
+
+	return func() {
+		server.Close()
+		os.Remove(knownHosts)
+	}, nil
+}
+
+func newFilesystem(t *testing.T, base string) extfs.Filesystem {
+	fs, err := New(server.Addr(), filepath.Join(server.Dir(), base), &extfs.Config{
+		User:              testUser,
+		Password:          testPassword,
+		SSHKnownHostsFile: knownHosts,
+	})
+	require.NoError(t, err)
+
+	return fs
+}
+
+func TestFilesystem(t *testing.T) {
+	extfstest.Test(t, extfstest.Config{New: newFilesystem, Append: true, RandomAccess: true})
+}
+
+func TestCreate(t *testing.T) {
+	fs := newFilesystem(t, "/test1")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "dir/myfile.txt", []byte("Hello world"))
+	data, err := ioutil.ReadFile(filepath.Join(server.Dir(), "test1/dir/myfile.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", string(data))
+
+	f, err := fs.Open("dir/myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "dir/myfile.txt", f.Name())
+	require.NoError(t, f.Close())
+}
+
+func TestRandomAccess(t *testing.T) {
+	fs := newFilesystem(t, "/test2")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+
+	f, err := fs.OpenFile("myfile.txt", os.O_RDWR, 0)
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = f.WriteAt([]byte("W"), 6)
+	require.NoError(t, err)
+
+	buf := make([]byte, 5)
+	_, err = f.ReadAt(buf, 6)
+	require.NoError(t, err)
+	assert.Equal(t, "World", string(buf))
+
+	pos, err := f.Seek(-5, io.SeekEnd)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), pos)
+	_, err = f.Write([]byte("there!"))
+	require.NoError(t, err)
+
+	require.NoError(t, f.Truncate(5))
+	assert.Equal(t, extfs.ErrUnsupported, f.Sync())
+
+	fi, err := f.Stat()
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), fi.Size())
+	assert.Equal(t, "Hello", string(extfstest.ReadFile(t, fs, "myfile.txt")))
+}
+
+func TestOpenFile(t *testing.T) {
+	fs := newFilesystem(t, "/test3")
+	defer fs.Close()
+
+	f, err := fs.OpenFile("private.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	fi, err := fs.Stat("private.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+}
+
+func TestDirectories(t *testing.T) {
+	fs := newFilesystem(t, "/test4")
+	defer fs.Close()
+
+	require.NoError(t, fs.MkdirAll("a/b", 0700))
+	fi, err := fs.Stat("a/b")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), fi.Mode().Perm())
+
+	// The links are removed, not the files they point to.
+	extfstest.WriteFile(t, fs, "outside/keep.txt", []byte("keep"))
+	require.NoError(t, os.Symlink(filepath.Join(server.Dir(), "test4/outside"), filepath.Join(server.Dir(), "test4/a/link")))
+	require.NoError(t, fs.RemoveAll("a"))
+	_, err = fs.Stat("a")
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, "keep", string(extfstest.ReadFile(t, fs, "outside/keep.txt")))
+}
+
+func TestReplace(t *testing.T) {
+	fs := newFilesystem(t, "/test9")
+	defer fs.Close()
+	sshfs := fs.(*sshfs)
+
+	extfstest.WriteFile(t, fs, "src.txt", []byte("source"))
+	extfstest.WriteFile(t, fs, "dst.txt", []byte("destination"))
+	require.NoError(t, fs.MkdirAll("dir", 0755))
+
+	// The rename fails, the destination is kept.
+	err := sshfs.replace(sshfs.base+"/missing.txt", sshfs.base+"/dst.txt")
+	assert.True(t, os.IsNotExist(err))
+	err = sshfs.replace(sshfs.base+"/dir", sshfs.base+"/dst.txt")
+	assert.Error(t, err)
+	assert.Equal(t, "destination", string(extfstest.ReadFile(t, fs, "dst.txt")))
+
+	require.NoError(t, sshfs.replace(sshfs.base+"/src.txt", sshfs.base+"/dst.txt"))
+	assert.Equal(t, "source", string(extfstest.ReadFile(t, fs, "dst.txt")))
+
+	entries, err := fs.ReadDir("")
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestChange(t *testing.T) {
+	fs := newFilesystem(t, "/test6")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+
+	require.NoError(t, fs.Chmod("myfile.txt", 0640))
+	mtime := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, fs.Chtimes("myfile.txt", mtime, mtime))
+
+	fi, err := fs.Stat("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), fi.Mode().Perm())
+	assert.True(t, mtime.Equal(fi.ModTime()))
+
+	err = fs.Chmod("missing.txt", 0640)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestStatFS(t *testing.T) {
+	fs := newFilesystem(t, "/test7/not/created")
+	defer fs.Close()
+
+	st, err := fs.(extfs.StatFS).StatFS()
+	require.NoError(t, err)
+	assert.True(t, st.Capacity > 0)
+	assert.True(t, st.Remaining <= st.Capacity)
+}
+
+func TestAuthentication(t *testing.T) {
+	fs, err := New(server.Addr(), server.Dir(), &extfs.Config{
+		User:              testUser,
+		SSHPrivateKey:     privateKey,
+		SSHKnownHostsFile: knownHosts,
+	})
+	require.NoError(t, err)
+	_, err = fs.Stat("/")
+	assert.NoError(t, err)
+	fs.Close()
+
+	keyFile := filepath.Join(server.Dir(), "id_ed25519")
+	require.NoError(t, ioutil.WriteFile(keyFile, privateKey, 0600))
+	fs, err = New(server.Addr(), server.Dir(), &extfs.Config{
+		User:              testUser,
+		SSHPrivateKeyFile: keyFile,
+		SSHKnownHostsFile: knownHosts,
+	})
+	require.NoError(t, err)
+	fs.Close()
+
+	_, err = New(server.Addr(), server.Dir(), &extfs.Config{
+		User:              testUser,
+		Password:          "wrong",
+		SSHKnownHostsFile: knownHosts,
+	})
+	assert.Error(t, err)
+
+	_, err = New(server.Addr(), server.Dir(), &extfs.Config{
+		User:              testUser,
+		SSHKnownHostsFile: knownHosts,
+	})
+	assert.Equal(t, errNoAuthMethod, err)
+
+	// The host key of the server is unknown.
+	empty := filepath.Join(server.Dir(), "known_hosts")
+	require.NoError(t, ioutil.WriteFile(empty, nil, 0600))
+	_, err = New(server.Addr(), server.Dir(), &extfs.Config{
+		User:              testUser,
+		Password:          testPassword,
+		SSHKnownHostsFile: empty,
+	})
+	assert.Error(t, err)
+
+	fs, err = New(server.Addr(), server.Dir(), &extfs.Config{
+		User:                     testUser,
+		Password:                 testPassword,
+		SSHInsecureIgnoreHostKey: true,
+	})
+	require.NoError(t, err)
+	fs.Close()
+}
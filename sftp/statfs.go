@@ -0,0 +1,45 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sftp
+
+import (
+	"os"
+	"path"
+
+	"github.com/pkg/sftp"
+	"github.com/rkcloudchain/extfs"
+)
+
+// StatFS returns the capacity of the filesystem of the base directory. It
+// requires the statvfs extension of OpenSSH.
+func (fs *sshfs) StatFS() (*extfs.FsStat, error) {
+	if _, ok := fs.client.HasExtension(statVFSExtension); !ok {
+		return nil, &os.PathError{Op: "statfs", Path: fs.base, Err: extfs.ErrUnsupported}
+	}
+
+	// The base directory may not be created yet, use the filesystem it will
+	// be created on.
+	dir := fs.base
+	var st *sftp.StatVFS
+	for {
+		var err error
+		st, err = fs.client.StatVFS(dir)
+		if err == nil {
+			break
+		}
+		if !os.IsNotExist(err) || path.Dir(dir) == dir {
+			return nil, &os.PathError{Op: "statfs", Path: dir, Err: interpretError(err)}
+		}
+		dir = path.Dir(dir)
+	}
+
+	return &extfs.FsStat{
+		Capacity:  st.Blocks * st.Frsize,
+		Used:      (st.Blocks - st.Bfree) * st.Frsize,
+		Remaining: st.Bavail * st.Frsize,
+	}, nil
+}
@@ -0,0 +1,20 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+// FsStat describes the capacity of a filesystem, in bytes.
+type FsStat struct {
+	Capacity  uint64
+	Used      uint64
+	Remaining uint64
+}
+
+// StatFS is implemented by the filesystems which can report their capacity.
+type StatFS interface {
+	// StatFS returns the capacity statistics of the filesystem.
+	StatFS() (*FsStat, error)
+}
@@ -0,0 +1,24 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+import "os"
+
+// Symlinker is implemented by the filesystems which have symbolic links.
+type Symlinker interface {
+	// Lstat returns a FileInfo describing the named file. If the file is a
+	// symbolic link, it describes the link itself.
+	Lstat(name string) (os.FileInfo, error)
+
+	// Readlink returns the target of the named symbolic link.
+	Readlink(name string) (string, error)
+
+	// Symlink creates newname as a symbolic link to oldname. oldname is
+	// stored as is, a relative target is relative to the directory of the
+	// link.
+	Symlink(oldname, newname string) error
+}
@@ -0,0 +1,103 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+)
+
+// ExportTar writes the tree under root to w as a tar archive, with the
+// modes and the modification times of the files, their ownership on the
+// filesystems which record it and the symbolic links on the filesystems
+// which have them. The archive is not compressed.
+func ExportTar(fs Filesystem, root string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := walkTree(fs, root, func(fullpath, name string, fi os.FileInfo, target string) error {
+		hdr, err := tar.FileInfoHeader(fi, target)
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+		if fi.IsDir() {
+			hdr.Name += "/"
+		}
+		// The hard links of an archive are exported as copies, their target
+		// may be outside of the tree.
+		if hdr.Typeflag == tar.TypeLink {
+			hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeReg, "", fi.Size()
+		}
+		if user, group := owner(fs, fullpath); user != "" || group != "" {
+			hdr.Uname, hdr.Gname = user, group
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil
+		}
+
+		f, err := fs.Open(fullpath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// ImportTar writes the entries of the tar archive read from r under root.
+// The entries whose names or link targets go up out of root are rejected
+// with ErrCrossedBoundary. The hard links are imported as copies, the
+// devices and the named pipes are skipped. A compressed archive is to be
+// decompressed by r.
+func ImportTar(fs Filesystem, root string, r io.Reader) error {
+	im := newImporter(fs, root)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		e := &entry{
+			name:   hdr.Name,
+			mode:   hdr.FileInfo().Mode(),
+			mtime:  hdr.ModTime,
+			user:   hdr.Uname,
+			group:  hdr.Gname,
+			target: hdr.Linkname,
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = im.dir(e)
+		case tar.TypeReg, tar.TypeRegA:
+			err = im.file(e, tr)
+		case tar.TypeLink:
+			err = im.hardlink(e)
+		case tar.TypeSymlink:
+			err = im.symlink(e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return im.finish()
+}
@@ -0,0 +1,12 @@
+package hdfs
+
+import "google.golang.org/protobuf/proto"
+
+// Execute sends an RPC request to the namenode and unmarshals its response
+// into resp. It gives access to the RPCs of the ClientNamenodeProtocol which
+// the client does not wrap, on the connection of the client.
+//
+// Execute is not part of the upstream library.
+func (c *Client) Execute(method string, req, resp proto.Message) error {
+	return c.namenode.Execute(method, req, resp)
+}
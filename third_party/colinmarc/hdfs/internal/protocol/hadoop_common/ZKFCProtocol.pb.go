@@ -0,0 +1,349 @@
+//*
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//*
+// These .proto interfaces are private and stable.
+// Please see http://wiki.apache.org/hadoop/Compatibility
+// for what changes are allowed for a *stable* .proto interface.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
+// source: ZKFCProtocol.proto
+
+package hadoop_common
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CedeActiveRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MillisToCede *uint32 `protobuf:"varint,1,req,name=millisToCede" json:"millisToCede,omitempty"`
+}
+
+func (x *CedeActiveRequestProto) Reset() {
+	*x = CedeActiveRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ZKFCProtocol_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CedeActiveRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CedeActiveRequestProto) ProtoMessage() {}
+
+func (x *CedeActiveRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_ZKFCProtocol_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CedeActiveRequestProto.ProtoReflect.Descriptor instead.
+func (*CedeActiveRequestProto) Descriptor() ([]byte, []int) {
+	return file_ZKFCProtocol_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CedeActiveRequestProto) GetMillisToCede() uint32 {
+	if x != nil && x.MillisToCede != nil {
+		return *x.MillisToCede
+	}
+	return 0
+}
+
+type CedeActiveResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CedeActiveResponseProto) Reset() {
+	*x = CedeActiveResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ZKFCProtocol_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CedeActiveResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CedeActiveResponseProto) ProtoMessage() {}
+
+func (x *CedeActiveResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_ZKFCProtocol_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CedeActiveResponseProto.ProtoReflect.Descriptor instead.
+func (*CedeActiveResponseProto) Descriptor() ([]byte, []int) {
+	return file_ZKFCProtocol_proto_rawDescGZIP(), []int{1}
+}
+
+type GracefulFailoverRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GracefulFailoverRequestProto) Reset() {
+	*x = GracefulFailoverRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ZKFCProtocol_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GracefulFailoverRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GracefulFailoverRequestProto) ProtoMessage() {}
+
+func (x *GracefulFailoverRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_ZKFCProtocol_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GracefulFailoverRequestProto.ProtoReflect.Descriptor instead.
+func (*GracefulFailoverRequestProto) Descriptor() ([]byte, []int) {
+	return file_ZKFCProtocol_proto_rawDescGZIP(), []int{2}
+}
+
+type GracefulFailoverResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GracefulFailoverResponseProto) Reset() {
+	*x = GracefulFailoverResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ZKFCProtocol_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GracefulFailoverResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GracefulFailoverResponseProto) ProtoMessage() {}
+
+func (x *GracefulFailoverResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_ZKFCProtocol_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GracefulFailoverResponseProto.ProtoReflect.Descriptor instead.
+func (*GracefulFailoverResponseProto) Descriptor() ([]byte, []int) {
+	return file_ZKFCProtocol_proto_rawDescGZIP(), []int{3}
+}
+
+var File_ZKFCProtocol_proto protoreflect.FileDescriptor
+
+var file_ZKFCProtocol_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x5a, 0x4b, 0x46, 0x43, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x16, 0x43, 0x65, 0x64, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x54, 0x6f, 0x43, 0x65, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x54, 0x6f, 0x43, 0x65, 0x64,
+	0x65, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x65, 0x64, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1e, 0x0a, 0x1c,
+	0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1f, 0x0a, 0x1d,
+	0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe1, 0x01,
+	0x0a, 0x13, 0x5a, 0x4b, 0x46, 0x43, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x63, 0x65, 0x64, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x25, 0x2e, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x65, 0x64, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x2e, 0x68, 0x61, 0x64,
+	0x6f, 0x6f, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x65, 0x64, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x6d, 0x0a, 0x10, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x46, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x46,
+	0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x2e, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x46, 0x61, 0x69, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x42, 0x74, 0x0a, 0x1a, 0x6f, 0x72, 0x67, 0x2e, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e, 0x68, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x42,
+	0x12, 0x5a, 0x4b, 0x46, 0x43, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x6c, 0x69, 0x6e, 0x6d, 0x61, 0x72, 0x63, 0x2f, 0x68, 0x64, 0x66, 0x73, 0x2f, 0x76,
+	0x32, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0xa0, 0x01, 0x01,
+}
+
+var (
+	file_ZKFCProtocol_proto_rawDescOnce sync.Once
+	file_ZKFCProtocol_proto_rawDescData = file_ZKFCProtocol_proto_rawDesc
+)
+
+func file_ZKFCProtocol_proto_rawDescGZIP() []byte {
+	file_ZKFCProtocol_proto_rawDescOnce.Do(func() {
+		file_ZKFCProtocol_proto_rawDescData = protoimpl.X.CompressGZIP(file_ZKFCProtocol_proto_rawDescData)
+	})
+	return file_ZKFCProtocol_proto_rawDescData
+}
+
+var file_ZKFCProtocol_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ZKFCProtocol_proto_goTypes = []interface{}{
+	(*CedeActiveRequestProto)(nil),        // 0: hadoop.common.CedeActiveRequestProto
+	(*CedeActiveResponseProto)(nil),       // 1: hadoop.common.CedeActiveResponseProto
+	(*GracefulFailoverRequestProto)(nil),  // 2: hadoop.common.GracefulFailoverRequestProto
+	(*GracefulFailoverResponseProto)(nil), // 3: hadoop.common.GracefulFailoverResponseProto
+}
+var file_ZKFCProtocol_proto_depIdxs = []int32{
+	0, // 0: hadoop.common.ZKFCProtocolService.cedeActive:input_type -> hadoop.common.CedeActiveRequestProto
+	2, // 1: hadoop.common.ZKFCProtocolService.gracefulFailover:input_type -> hadoop.common.GracefulFailoverRequestProto
+	1, // 2: hadoop.common.ZKFCProtocolService.cedeActive:output_type -> hadoop.common.CedeActiveResponseProto
+	3, // 3: hadoop.common.ZKFCProtocolService.gracefulFailover:output_type -> hadoop.common.GracefulFailoverResponseProto
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ZKFCProtocol_proto_init() }
+func file_ZKFCProtocol_proto_init() {
+	if File_ZKFCProtocol_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ZKFCProtocol_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CedeActiveRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ZKFCProtocol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CedeActiveResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ZKFCProtocol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GracefulFailoverRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ZKFCProtocol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GracefulFailoverResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ZKFCProtocol_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ZKFCProtocol_proto_goTypes,
+		DependencyIndexes: file_ZKFCProtocol_proto_depIdxs,
+		MessageInfos:      file_ZKFCProtocol_proto_msgTypes,
+	}.Build()
+	File_ZKFCProtocol_proto = out.File
+	file_ZKFCProtocol_proto_rawDesc = nil
+	file_ZKFCProtocol_proto_goTypes = nil
+	file_ZKFCProtocol_proto_depIdxs = nil
+}
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// securityLayers are the bits of the SASL GSSAPI security layers (RFC 4752)
+// matching the levels of hadoop.rpc.protection.
+var securityLayers = map[string]byte{
+	"authentication": 1,
+	"integrity":      2,
+	"privacy":        4,
+}
+
+// checkRPCProtection validates the RPC protection of the options. Only
+// kerberos connections negotiate a security layer, the others are just
+// authenticated.
+func checkRPCProtection(options NamenodeConnectionOptions) error {
+	if options.RPCProtection == "" {
+		return nil
+	}
+	if _, ok := securityLayers[options.RPCProtection]; !ok {
+		return fmt.Errorf("invalid RPC protection: %s", options.RPCProtection)
+	}
+	if options.KerberosClient == nil && options.RPCProtection != "authentication" {
+		return fmt.Errorf("%s RPC protection requires kerberos", options.RPCProtection)
+	}
+
+	return nil
+}
+
+// selectSecurityLayer returns the reply to the security layers offered by the
+// namenode, which selects the layer of protection. The payload of the
+// namenode is the bit mask of the layers it offers followed by its maximum
+// message size, which the reply keeps.
+func selectSecurityLayer(payload []byte, protection string) ([]byte, error) {
+	if len(payload) != 4 {
+		return nil, errors.New("unexpected security layer negotiation payload")
+	}
+
+	layer := securityLayers[protection]
+	if payload[0]&layer == 0 {
+		return nil, fmt.Errorf("namenode does not offer %s RPC protection", protection)
+	}
+
+	return []byte{layer, payload[1], payload[2], payload[3]}, nil
+}
@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"testing"
+
+	krb "github.com/jcmturner/gokrb5/v8/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRPCProtection(t *testing.T) {
+	assert.NoError(t, checkRPCProtection(NamenodeConnectionOptions{}))
+	assert.NoError(t, checkRPCProtection(NamenodeConnectionOptions{RPCProtection: "authentication"}))
+	assert.Error(t, checkRPCProtection(NamenodeConnectionOptions{RPCProtection: "privacy"}))
+	assert.Error(t, checkRPCProtection(NamenodeConnectionOptions{RPCProtection: "secret"}))
+	assert.NoError(t, checkRPCProtection(NamenodeConnectionOptions{
+		RPCProtection:  "privacy",
+		KerberosClient: &krb.Client{},
+	}))
+}
+
+func TestSelectSecurityLayer(t *testing.T) {
+	offered := []byte{1 | 4, 0x01, 0x00, 0x00}
+
+	reply, err := selectSecurityLayer(offered, "privacy")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4, 0x01, 0x00, 0x00}, reply)
+
+	reply, err = selectSecurityLayer(offered, "authentication")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 0x01, 0x00, 0x00}, reply)
+
+	_, err = selectSecurityLayer(offered, "integrity")
+	assert.Error(t, err)
+	_, err = selectSecurityLayer([]byte{4}, "privacy")
+	assert.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package hdfs
+
+import (
+	"os"
+
+	hdfs "github.com/rkcloudchain/extfs/third_party/colinmarc/hdfs/internal/protocol/hadoop_hdfs"
+	"google.golang.org/protobuf/proto"
+)
+
+// Rename renames (moves) a file.
+func (c *Client) Rename(oldpath, newpath string) error {
+	_, err := c.getFileInfo(newpath)
+	err = interpretException(err)
+	if err != nil && !os.IsNotExist(err) {
+		return &os.PathError{Op: "rename", Path: newpath, Err: err}
+	}
+
+	req := &hdfs.Rename2RequestProto{
+		Src:           proto.String(oldpath),
+		Dst:           proto.String(newpath),
+		OverwriteDest: proto.Bool(true),
+	}
+	resp := &hdfs.Rename2ResponseProto{}
+
+	err = c.namenode.Execute("rename2", req, resp)
+	if err != nil {
+		return &os.PathError{Op: "rename", Path: oldpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
@@ -0,0 +1,364 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package trash
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+const (
+	currentDir = "Current"
+
+	// checkpointFormat is the yyMMddHHmmss layout used by hadoop for the
+	// names of the trash checkpoints.
+	checkpointFormat = "060102150405"
+
+	// millisDigits is the length of the millisecond times appended to the
+	// names of the removed versions, which tells them from other files
+	// whose names end with a number.
+	millisDigits = 13
+)
+
+// Trash is a filesystem which moves the removed files to a trash directory
+// instead of deleting them. Removed paths keep their location under
+// <dir>/Current until Checkpoint moves them to a timestamped checkpoint
+// directory, which ExpungeOlderThan eventually deletes.
+//
+// Trash only implements extfs.Filesystem. The optional interfaces of the
+// underlying filesystem, such as extfs.ACL, extfs.StatFS, extfs.Symlinker or
+// the snapshots and quotas of HDFS, are reached through Unwrap, and their
+// operations bypass the trash.
+type Trash struct {
+	extfs.Filesystem
+	dir string
+	now func() time.Time
+}
+
+// New returns a filesystem moving the files removed from fs to dir. dir is
+// relative to the root of fs, removing a path inside dir deletes it for good.
+func New(fs extfs.Filesystem, dir string) (*Trash, error) {
+	dir, err := clean(dir)
+	if err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		return nil, errors.New("The trash directory cannot be the root")
+	}
+
+	return &Trash{Filesystem: fs, dir: dir, now: time.Now}, nil
+}
+
+// DefaultDir returns the trash directory of the given user.
+func DefaultDir(user string) string {
+	return filepath.Join(".Trash", user)
+}
+
+// Unwrap returns the underlying filesystem, which gives access to its
+// optional interfaces and removes files permanently.
+func (t *Trash) Unwrap() extfs.Filesystem {
+	return t.Filesystem
+}
+
+// Remove moves the named file or empty directory to the trash.
+func (t *Trash) Remove(filename string) error {
+	name, err := clean(filename)
+	if err != nil {
+		return err
+	}
+	if t.inTrash(name) {
+		return t.Filesystem.Remove(filename)
+	}
+
+	fi, err := t.Filesystem.Stat(name)
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		entries, err := t.Filesystem.ReadDir(name)
+		if err != nil {
+			return err
+		}
+		if len(entries) != 0 {
+			return &os.PathError{Op: "remove", Path: filename, Err: syscall.ENOTEMPTY}
+		}
+	}
+
+	return t.moveToTrash(name)
+}
+
+// RemoveAll moves path and any children it contains to the trash. Removing
+// the root, or a parent of the trash directory, moves all of its children
+// except the trash directory, and keeps its parents.
+func (t *Trash) RemoveAll(path string) error {
+	name, err := clean(path)
+	if err != nil {
+		return err
+	}
+	if t.inTrash(name) {
+		return t.Filesystem.RemoveAll(path)
+	}
+
+	if t.aboveTrash(name) {
+		entries, err := t.Filesystem.ReadDir(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		for _, fi := range entries {
+			child := filepath.Join(name, fi.Name())
+			if child == t.dir {
+				continue
+			}
+			if err := t.RemoveAll(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	_, err = t.Filesystem.Stat(name)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return t.moveToTrash(name)
+}
+
+// Restore moves the most recently removed version of the named path back to
+// its original location. The current trash is searched first, then the
+// checkpoints from the newest to the oldest.
+func (t *Trash) Restore(name string) error {
+	name, err := clean(name)
+	if err != nil {
+		return err
+	}
+
+	roots := []string{currentDir}
+	checkpoints, err := t.checkpoints()
+	if err != nil {
+		return err
+	}
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		roots = append(roots, checkpoints[i].Name())
+	}
+
+	for _, root := range roots {
+		trashed, err := t.newest(filepath.Join(t.dir, root), name)
+		if err != nil {
+			return err
+		}
+		if trashed == "" {
+			continue
+		}
+
+		if _, err := t.Filesystem.Stat(name); err == nil {
+			return &os.PathError{Op: "restore", Path: name, Err: os.ErrExist}
+		}
+		if err := t.mkdirParent(name); err != nil {
+			return err
+		}
+		return t.Filesystem.Rename(trashed, name)
+	}
+
+	return &os.PathError{Op: "restore", Path: name, Err: os.ErrNotExist}
+}
+
+// Checkpoint moves the current trash to a new checkpoint named after the
+// current time.
+func (t *Trash) Checkpoint() error {
+	current := filepath.Join(t.dir, currentDir)
+	if _, err := t.Filesystem.Stat(current); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	base := filepath.Join(t.dir, t.now().Format(checkpointFormat))
+	checkpoint := base
+	for i := 1; ; i++ {
+		_, err := t.Filesystem.Stat(checkpoint)
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		checkpoint = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	return t.Filesystem.Rename(current, checkpoint)
+}
+
+// ExpungeOlderThan permanently deletes the checkpoints older than age.
+func (t *Trash) ExpungeOlderThan(age time.Duration) error {
+	checkpoints, err := t.checkpoints()
+	if err != nil {
+		return err
+	}
+
+	deadline := t.now().Add(-age)
+	for _, fi := range checkpoints {
+		created, _ := parseCheckpoint(fi.Name())
+		if !created.Before(deadline) {
+			continue
+		}
+		if err := t.Filesystem.RemoveAll(filepath.Join(t.dir, fi.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkpoints returns the checkpoint directories sorted from the oldest to
+// the newest.
+func (t *Trash) checkpoints() ([]os.FileInfo, error) {
+	entries, err := t.Filesystem.ReadDir(t.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints []os.FileInfo
+	for _, fi := range entries {
+		if _, ok := parseCheckpoint(fi.Name()); ok && fi.IsDir() {
+			checkpoints = append(checkpoints, fi)
+		}
+	}
+
+	sort.SliceStable(checkpoints, func(i, j int) bool {
+		ti, _ := parseCheckpoint(checkpoints[i].Name())
+		tj, _ := parseCheckpoint(checkpoints[j].Name())
+		return ti.Before(tj)
+	})
+
+	return checkpoints, nil
+}
+
+func (t *Trash) moveToTrash(name string) error {
+	target := filepath.Join(t.dir, currentDir, name)
+	if err := t.mkdirParent(target); err != nil {
+		return err
+	}
+
+	// Like hadoop, keep the previously removed version and add the time to
+	// the name of the new one. The removals within the same millisecond take
+	// the next free ones, so that the names still sort by removal.
+	if _, err := t.Filesystem.Stat(target); err == nil {
+		millis := t.now().UnixNano() / int64(time.Millisecond)
+		for {
+			versioned := fmt.Sprintf("%s%d", target, millis)
+			_, err := t.Filesystem.Stat(versioned)
+			if os.IsNotExist(err) {
+				target = versioned
+				break
+			}
+			if err != nil {
+				return err
+			}
+			millis++
+		}
+	}
+
+	return t.Filesystem.Rename(name, target)
+}
+
+// newest returns the most recently removed version of name in the trash
+// directory root, or "" if there is none. The first version keeps the name,
+// the later ones have the millisecond time of their removal appended to it.
+func (t *Trash) newest(root, name string) (string, error) {
+	dir := filepath.Join(root, filepath.Dir(name))
+	entries, err := t.Filesystem.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	base := filepath.Base(name)
+	newest, newestTime := "", int64(-1)
+	for _, fi := range entries {
+		if !strings.HasPrefix(fi.Name(), base) {
+			continue
+		}
+
+		var removed int64
+		if suffix := fi.Name()[len(base):]; suffix != "" {
+			if len(suffix) < millisDigits {
+				continue
+			}
+			if removed, err = strconv.ParseInt(suffix, 10, 64); err != nil || removed < 0 {
+				continue
+			}
+		}
+		if removed > newestTime {
+			newest, newestTime = filepath.Join(dir, fi.Name()), removed
+		}
+	}
+
+	return newest, nil
+}
+
+func (t *Trash) mkdirParent(name string) error {
+	dir := filepath.Dir(name)
+	if dir == "." {
+		return nil
+	}
+
+	return t.Filesystem.MkdirAll(dir, os.ModePerm)
+}
+
+func (t *Trash) inTrash(name string) bool {
+	return name == t.dir || strings.HasPrefix(name, t.dir+string(filepath.Separator))
+}
+
+// aboveTrash reports whether name is the root or a parent of the trash
+// directory.
+func (t *Trash) aboveTrash(name string) bool {
+	return name == "" || strings.HasPrefix(t.dir, name+string(filepath.Separator))
+}
+
+func parseCheckpoint(name string) (time.Time, bool) {
+	if i := strings.IndexByte(name, '-'); i >= 0 {
+		name = name[:i]
+	}
+
+	created, err := time.ParseInLocation(checkpointFormat, name, time.Local)
+	return created, err == nil
+}
+
+// clean returns the path relative to the root of the filesystem, "" for the
+// root itself.
+func clean(name string) (string, error) {
+	name, err := util.UnderlyingPath(string(filepath.Separator), name)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(name, string(filepath.Separator)), nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package trash
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTrash(t *testing.T) *Trash {
+	tp := filepath.Join(os.TempDir(), "extfs-trash-test")
+	require.NoError(t, os.RemoveAll(tp))
+
+	tr, err := New(local.New(tp), DefaultDir("alice"))
+	require.NoError(t, err)
+	return tr
+}
+
+func createFile(t *testing.T, fs extfs.Filesystem, name string) {
+	f, err := fs.Create(name)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestRemove(t *testing.T) {
+	tr := newTestTrash(t)
+	createFile(t, tr, "foo/bar")
+
+	err := tr.Remove("foo")
+	assert.Error(t, err)
+
+	err = tr.Remove("foo/bar")
+	require.NoError(t, err)
+
+	_, err = tr.Stat("foo/bar")
+	assert.True(t, os.IsNotExist(err))
+	_, err = tr.Stat(".Trash/alice/Current/foo/bar")
+	assert.NoError(t, err)
+
+	createFile(t, tr, "foo/bar")
+	err = tr.Remove("foo/bar")
+	require.NoError(t, err)
+
+	entries, err := tr.ReadDir(".Trash/alice/Current/foo")
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestRemoveAllAndRestore(t *testing.T) {
+	tr := newTestTrash(t)
+	createFile(t, tr, "foo/bar")
+	createFile(t, tr, "qux")
+
+	err := tr.RemoveAll("")
+	require.NoError(t, err)
+
+	entries, err := tr.ReadDir("")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, ".Trash", entries[0].Name())
+
+	// The parents of the trash directory are kept.
+	createFile(t, tr, "baz")
+	err = tr.RemoveAll("")
+	require.NoError(t, err)
+
+	entries, err = tr.ReadDir("")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, ".Trash", entries[0].Name())
+	_, err = tr.Stat(".Trash/alice/Current/baz")
+	assert.NoError(t, err)
+
+	err = tr.RemoveAll("missing")
+	assert.NoError(t, err)
+
+	err = tr.Restore("foo")
+	require.NoError(t, err)
+	_, err = tr.Stat("foo/bar")
+	assert.NoError(t, err)
+
+	err = tr.Restore("foo")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRestoreNewest(t *testing.T) {
+	tr := newTestTrash(t)
+	now := time.Date(2019, 3, 1, 10, 0, 0, 0, time.Local)
+	tr.now = func() time.Time { return now }
+
+	for _, data := range []string{"v1", "v2", "v3"} {
+		f, err := tr.Create("foo/bar")
+		require.NoError(t, err)
+		_, err = f.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.NoError(t, tr.Remove("foo/bar"))
+		now = now.Add(time.Second)
+	}
+	createFile(t, tr, ".Trash/alice/Current/foo/bar1")
+
+	for _, data := range []string{"v3", "v2", "v1"} {
+		require.NoError(t, tr.Restore("foo/bar"))
+		f, err := tr.Open("foo/bar")
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		assert.Equal(t, data, string(content))
+		require.NoError(t, tr.Unwrap().Remove("foo/bar"))
+	}
+
+	err := tr.Restore("foo/bar")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRemoveWithinOneTick(t *testing.T) {
+	tr := newTestTrash(t)
+	now := time.Date(2019, 3, 1, 10, 0, 0, 0, time.Local)
+	tr.now = func() time.Time { return now }
+
+	versions := []string{"v1", "v2", "v3", "v4", "v5"}
+	for _, data := range versions {
+		f, err := tr.Create("a")
+		require.NoError(t, err)
+		_, err = f.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.NoError(t, tr.Remove("a"))
+	}
+
+	entries, err := tr.ReadDir(".Trash/alice/Current")
+	require.NoError(t, err)
+	assert.Len(t, entries, len(versions))
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		require.NoError(t, tr.Restore("a"))
+		f, err := tr.Open("a")
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		assert.Equal(t, versions[i], string(content))
+		require.NoError(t, tr.Unwrap().Remove("a"))
+	}
+}
+
+func TestCheckpointAndExpunge(t *testing.T) {
+	tr := newTestTrash(t)
+	now := time.Date(2019, 3, 1, 10, 0, 0, 0, time.Local)
+	tr.now = func() time.Time { return now }
+
+	createFile(t, tr, "foo")
+	require.NoError(t, tr.Remove("foo"))
+	require.NoError(t, tr.Checkpoint())
+
+	_, err := tr.Stat(".Trash/alice/190301100000/foo")
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	createFile(t, tr, "foo")
+	require.NoError(t, tr.Remove("foo"))
+	require.NoError(t, tr.Checkpoint())
+
+	now = now.Add(time.Hour)
+	err = tr.ExpungeOlderThan(90 * time.Minute)
+	require.NoError(t, err)
+
+	entries, err := tr.ReadDir(".Trash/alice")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "190301110000", entries[0].Name())
+
+	err = tr.Restore("foo")
+	require.NoError(t, err)
+	_, err = tr.Stat("foo")
+	assert.NoError(t, err)
+}
+
+func TestRemoveInTrash(t *testing.T) {
+	tr := newTestTrash(t)
+	createFile(t, tr, "foo")
+	require.NoError(t, tr.Remove("foo"))
+
+	err := tr.RemoveAll(".Trash/alice/Current/foo")
+	require.NoError(t, err)
+	_, err = tr.Stat(".Trash/alice/Current/foo")
+	assert.True(t, os.IsNotExist(err))
+
+	err = tr.Remove("../foo")
+	assert.Equal(t, extfs.ErrCrossedBoundary, err)
+}
+
+func TestUnwrap(t *testing.T) {
+	tr := newTestTrash(t)
+
+	var fs extfs.Filesystem = tr
+	_, ok := fs.(extfs.StatFS)
+	assert.False(t, ok)
+	_, ok = fs.(extfs.Symlinker)
+	assert.False(t, ok)
+
+	statfs, ok := tr.Unwrap().(extfs.StatFS)
+	require.True(t, ok)
+	_, err := statfs.StatFS()
+	assert.NoError(t, err)
+	_, ok = tr.Unwrap().(extfs.Symlinker)
+	assert.True(t, ok)
+}
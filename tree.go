@@ -0,0 +1,322 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+import (
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// entry describes a file of an archive being imported.
+type entry struct {
+	name   string
+	mode   os.FileMode
+	mtime  time.Time
+	user   string
+	group  string
+	target string
+}
+
+// walkTree calls fn for the directories, the regular files and the
+// symbolic links under root, parents first, with their path relative to
+// root. The symbolic links are passed with their target on the filesystems
+// which have them. Elsewhere the links to files are followed, the other
+// ones are skipped.
+func walkTree(fs Filesystem, root string, fn func(fullpath, name string, fi os.FileInfo, target string) error) error {
+	fi, err := fs.Stat(root)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return &os.PathError{Op: "walk", Path: root, Err: syscall.ENOTDIR}
+	}
+
+	symlinker, _ := fs.(Symlinker)
+	var walk func(dir, prefix string) error
+	walk = func(dir, prefix string) error {
+		fis, err := fs.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+
+		for _, fi := range fis {
+			fullpath := path.Join(dir, fi.Name())
+			name := prefix + fi.Name()
+
+			var target string
+			if fi.Mode()&os.ModeSymlink != 0 {
+				if symlinker != nil {
+					if target, err = symlinker.Readlink(fullpath); err != nil {
+						return err
+					}
+				} else {
+					if fi, err = fs.Stat(fullpath); err != nil {
+						return err
+					}
+					if fi.IsDir() {
+						continue
+					}
+				}
+			}
+
+			switch {
+			case fi.IsDir():
+				if err := fn(fullpath, name, fi, ""); err != nil {
+					return err
+				}
+				if err := walk(fullpath, name+"/"); err != nil {
+					return err
+				}
+			case fi.Mode().IsRegular(), target != "":
+				if err := fn(fullpath, name, fi, target); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return walk(root, "")
+}
+
+// owner returns the user and the group owning a file, or empty names if
+// the filesystem does not record them.
+func owner(fs Filesystem, fullpath string) (string, string) {
+	if c, ok := fs.(Chowner); ok {
+		if user, group, err := c.Owner(fullpath); err == nil {
+			return user, group
+		}
+	}
+
+	return "", ""
+}
+
+// entryName returns the cleaned name of an archive entry, relative to the
+// destination. The names which go up out of the destination are rejected,
+// like util.UnderlyingPath does, which this package cannot use.
+func entryName(name string) (string, error) {
+	name = path.Clean(filepath.ToSlash(name))
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return "", ErrCrossedBoundary
+	}
+
+	return strings.TrimPrefix(name, "/"), nil
+}
+
+// importer writes the entries of an archive under root. The symbolic links
+// are created last, so that no entry is written through one of them, and
+// the attributes of the directories are set once their content is written.
+type importer struct {
+	fs    Filesystem
+	root  string
+	dirs  []*entry
+	links []*entry
+}
+
+func newImporter(fs Filesystem, root string) *importer {
+	return &importer{fs: fs, root: root}
+}
+
+// entryName returns the cleaned name of an entry, like entryName. The
+// entries under a symbolic link of the archive are rejected, since they would
+// be written through the link, and so are the links above such an entry: the
+// target of a link is checked against the directory of its name, which must
+// not be another link, like in "a -> ." followed by "a/b -> ..".
+func (im *importer) entryName(name string) (string, error) {
+	name, err := entryName(name)
+	if err != nil {
+		return "", err
+	}
+
+	for _, e := range im.links {
+		if strings.HasPrefix(name, e.name+"/") || strings.HasPrefix(e.name, name+"/") {
+			return "", ErrCrossedBoundary
+		}
+	}
+
+	return name, nil
+}
+
+func (im *importer) dir(e *entry) error {
+	name, err := im.entryName(e.name)
+	if err != nil {
+		return err
+	}
+
+	if err := im.fs.MkdirAll(path.Join(im.root, name), os.ModePerm); err != nil {
+		return err
+	}
+	e.name = name
+	im.dirs = append(im.dirs, e)
+
+	return nil
+}
+
+func (im *importer) file(e *entry, r io.Reader) error {
+	name, err := im.entryName(e.name)
+	if err != nil {
+		return err
+	}
+	fullpath := path.Join(im.root, name)
+
+	f, err := im.fs.Create(fullpath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return im.setAttrs(fullpath, e)
+}
+
+// hardlink copies the content of the target of a hard link, which has been
+// imported before it.
+func (im *importer) hardlink(e *entry) error {
+	target, err := im.entryName(e.target)
+	if err != nil {
+		return err
+	}
+
+	f, err := im.fs.Open(path.Join(im.root, target))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return im.file(e, f)
+}
+
+func (im *importer) symlink(e *entry) error {
+	name, err := im.entryName(e.name)
+	if err != nil {
+		return err
+	}
+	if path.IsAbs(e.target) {
+		return ErrCrossedBoundary
+	}
+	if _, err := entryName(path.Join(path.Dir(name), e.target)); err != nil {
+		return err
+	}
+
+	e.name = name
+	im.links = append(im.links, e)
+
+	return nil
+}
+
+// maxLinkHops bounds the number of links followed to resolve the target of a
+// link, like the limit of the kernels on the symbolic links of a path.
+const maxLinkHops = 40
+
+// resolveLink follows the target of a link through the other links of the
+// archive. Its components are resolved one by one, since joining the target
+// to the directory of the link as text misses the ".." after a link, like in
+// "d/l1 -> .." followed by "l2 -> d/l1/..". The targets which leave the
+// destination are rejected, and so are the chains too long to resolve.
+func (im *importer) resolveLink(e *entry, targets map[string]string) error {
+	var parts []string
+	if dir := path.Dir(e.name); dir != "." {
+		parts = strings.Split(dir, "/")
+	}
+	pending := strings.Split(e.target, "/")
+
+	hops := 0
+	for len(pending) > 0 {
+		c := pending[0]
+		pending = pending[1:]
+
+		switch c {
+		case "", ".":
+		case "..":
+			if len(parts) == 0 {
+				return ErrCrossedBoundary
+			}
+			parts = parts[:len(parts)-1]
+		default:
+			parts = append(parts, c)
+			target, ok := targets[strings.Join(parts, "/")]
+			if !ok {
+				continue
+			}
+			if hops++; hops > maxLinkHops || path.IsAbs(target) {
+				return ErrCrossedBoundary
+			}
+			parts = parts[:len(parts)-1]
+			pending = append(strings.Split(target, "/"), pending...)
+		}
+	}
+
+	return nil
+}
+
+// finish creates the symbolic links, where the filesystem has them, and
+// sets the attributes of the directories, the deepest first. The targets
+// are checked once every link of the archive is known, since a link may
+// pass through one which comes after it.
+func (im *importer) finish() error {
+	targets := make(map[string]string, len(im.links))
+	for _, e := range im.links {
+		targets[e.name] = e.target
+	}
+	for _, e := range im.links {
+		if err := im.resolveLink(e, targets); err != nil {
+			return err
+		}
+	}
+
+	if symlinker, ok := im.fs.(Symlinker); ok {
+		for _, e := range im.links {
+			fullpath := path.Join(im.root, e.name)
+			if err := symlinker.Symlink(e.target, fullpath); err != nil {
+				return err
+			}
+			im.chown(fullpath, e)
+		}
+	}
+
+	for i := len(im.dirs) - 1; i >= 0; i-- {
+		e := im.dirs[i]
+		if err := im.setAttrs(path.Join(im.root, e.name), e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) setAttrs(fullpath string, e *entry) error {
+	if err := im.fs.Chmod(fullpath, e.mode&os.ModePerm); err != nil && err != ErrUnsupported {
+		return err
+	}
+	im.chown(fullpath, e)
+	if !e.mtime.IsZero() {
+		if err := im.fs.Chtimes(fullpath, e.mtime, e.mtime); err != nil && err != ErrUnsupported {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chown restores the ownership of a file where the filesystem records it
+// and the user may change it.
+func (im *importer) chown(fullpath string, e *entry) {
+	if c, ok := im.fs.(Chowner); ok && (e.user != "" || e.group != "") {
+		c.Chown(fullpath, e.user, e.group)
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var treeTime = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func tempFilesystem(t *testing.T) (extfs.Filesystem, string) {
+	dir, err := ioutil.TempDir("", "extfs-tree")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	return local.New(dir), dir
+}
+
+// sourceTree returns a filesystem with a tree of files, a symbolic link and
+// a read-only directory under /src.
+func sourceTree(t *testing.T) extfs.Filesystem {
+	fs, dir := tempFilesystem(t)
+	src := filepath.Join(dir, "src")
+
+	files := map[string]string{
+		"hello.txt":          "Hello world",
+		"data/a.csv":         "a,b\n1,2\n",
+		"data/private.txt":   "secret",
+		"readonly/fixed.txt": "fixed",
+	}
+	for name, data := range files {
+		p := filepath.Join(src, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, ioutil.WriteFile(p, []byte(data), 0644))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "empty"), 0755))
+	require.NoError(t, os.Chmod(filepath.Join(src, "data/private.txt"), 0600))
+	require.NoError(t, os.Symlink("data/a.csv", filepath.Join(src, "link.csv")))
+	for _, name := range []string{"hello.txt", "data", "readonly/fixed.txt", "readonly"} {
+		require.NoError(t, os.Chtimes(filepath.Join(src, name), treeTime, treeTime))
+	}
+	require.NoError(t, os.Chmod(filepath.Join(src, "readonly"), 0555))
+	t.Cleanup(func() { os.Chmod(filepath.Join(src, "readonly"), 0755) })
+
+	return fs
+}
+
+func assertTree(t *testing.T, src, dst extfs.Filesystem, dir string) {
+	defer os.Chmod(filepath.Join(dir, "dst", "readonly"), 0755)
+
+	read := func(name string) string {
+		f, err := dst.Open(name)
+		require.NoError(t, err)
+		defer f.Close()
+		data, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "Hello world", read("dst/hello.txt"))
+	assert.Equal(t, "a,b\n1,2\n", read("dst/data/a.csv"))
+	assert.Equal(t, "fixed", read("dst/readonly/fixed.txt"))
+
+	fi, err := dst.Stat("dst/data/private.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode())
+
+	for _, name := range []string{"hello.txt", "data", "readonly/fixed.txt", "readonly"} {
+		fi, err = dst.Stat("dst/" + name)
+		require.NoError(t, err)
+		assert.True(t, treeTime.Equal(fi.ModTime()), name)
+	}
+	fi, err = dst.Stat("dst/readonly")
+	require.NoError(t, err)
+	assert.Equal(t, os.ModeDir|0555, fi.Mode())
+	fi, err = dst.Stat("dst/empty")
+	require.NoError(t, err)
+	assert.True(t, fi.IsDir())
+
+	symlinker := dst.(extfs.Symlinker)
+	fi, err = symlinker.Lstat("dst/link.csv")
+	require.NoError(t, err)
+	assert.True(t, fi.Mode()&os.ModeSymlink != 0)
+	target, err := symlinker.Readlink("dst/link.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "data/a.csv", target)
+
+	user, group, err := src.(extfs.Chowner).Owner("src/hello.txt")
+	require.NoError(t, err)
+	dstUser, dstGroup, err := dst.(extfs.Chowner).Owner("dst/hello.txt")
+	require.NoError(t, err)
+	assert.Equal(t, user, dstUser)
+	assert.Equal(t, group, dstGroup)
+}
+
+func TestExportImportTar(t *testing.T) {
+	src := sourceTree(t)
+	buf := &bytes.Buffer{}
+	require.NoError(t, extfs.ExportTar(src, "src", buf))
+
+	var names []string
+	tr := tar.NewReader(bytes.NewReader(buf.Bytes()))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+		if hdr.Name == "link.csv" {
+			assert.Equal(t, byte(tar.TypeSymlink), hdr.Typeflag)
+			assert.Equal(t, "data/a.csv", hdr.Linkname)
+		}
+	}
+	assert.Equal(t, []string{
+		"data/", "data/a.csv", "data/private.txt", "empty/", "hello.txt",
+		"link.csv", "readonly/", "readonly/fixed.txt",
+	}, names)
+
+	dst, dir := tempFilesystem(t)
+	require.NoError(t, extfs.ImportTar(dst, "dst", buf))
+	assertTree(t, src, dst, dir)
+}
+
+func TestExportImportZip(t *testing.T) {
+	src := sourceTree(t)
+	buf := &bytes.Buffer{}
+	require.NoError(t, extfs.ExportZip(src, "/src", buf))
+
+	dst, dir := tempFilesystem(t)
+	require.NoError(t, extfs.ImportZip(dst, "dst", bytes.NewReader(buf.Bytes()), int64(buf.Len())))
+	assertTree(t, src, dst, dir)
+}
+
+func TestImportEscapingEntries(t *testing.T) {
+	tarArchive := func(hdrs ...*tar.Header) io.Reader {
+		buf := &bytes.Buffer{}
+		w := tar.NewWriter(buf)
+		for _, hdr := range hdrs {
+			require.NoError(t, w.WriteHeader(hdr))
+		}
+		require.NoError(t, w.Close())
+		return buf
+	}
+
+	dst, dir := tempFilesystem(t)
+	for _, hdrs := range [][]*tar.Header{
+		{{Name: "../evil.txt", Typeflag: tar.TypeReg}},
+		{{Name: "data/../../evil.txt", Typeflag: tar.TypeReg}},
+		{{Name: "..", Typeflag: tar.TypeDir}},
+		{{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc"}},
+		{{Name: "data/link", Typeflag: tar.TypeSymlink, Linkname: "../.."}},
+		{{Name: "copy", Typeflag: tar.TypeLink, Linkname: "../../etc/passwd"}},
+		{
+			{Name: "a", Typeflag: tar.TypeSymlink, Linkname: "."},
+			{Name: "a/b", Typeflag: tar.TypeSymlink, Linkname: ".."},
+		},
+		{
+			{Name: "a/b", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			{Name: "a", Typeflag: tar.TypeSymlink, Linkname: "."},
+		},
+		{
+			{Name: "d/", Typeflag: tar.TypeDir, Mode: 0755},
+			{Name: "d/l1", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			{Name: "l2", Typeflag: tar.TypeSymlink, Linkname: "d/l1/.."},
+		},
+		{
+			{Name: "l2", Typeflag: tar.TypeSymlink, Linkname: "d/l1/.."},
+			{Name: "d/l1", Typeflag: tar.TypeSymlink, Linkname: ".."},
+		},
+	} {
+		err := extfs.ImportTar(dst, "dst", tarArchive(hdrs...))
+		assert.Equal(t, extfs.ErrCrossedBoundary, err, hdrs[0].Name)
+	}
+	_, err := os.Stat(filepath.Join(filepath.Dir(dir), "evil.txt"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Lstat(filepath.Join(dir, "dst", "b"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Lstat(filepath.Join(dir, "dst", "l2"))
+	assert.True(t, os.IsNotExist(err))
+
+	// The symbolic links are created after the files, so that no file is
+	// written through them.
+	err = extfs.ImportTar(dst, "dst", tarArchive(
+		&tar.Header{Name: "data/", Typeflag: tar.TypeDir, Mode: 0755},
+		&tar.Header{Name: "up", Typeflag: tar.TypeSymlink, Linkname: "data"},
+		&tar.Header{Name: "up/x.txt", Typeflag: tar.TypeReg, Mode: 0644},
+	))
+	assert.Error(t, err)
+	_, err = dst.Stat("dst/data/x.txt")
+	assert.True(t, os.IsNotExist(err))
+
+	zipArchive := func(write func(w *zip.Writer)) *bytes.Reader {
+		buf := &bytes.Buffer{}
+		w := zip.NewWriter(buf)
+		write(w)
+		require.NoError(t, w.Close())
+		return bytes.NewReader(buf.Bytes())
+	}
+	zipSymlink := func(w *zip.Writer, name, target string) {
+		hdr := &zip.FileHeader{Name: name}
+		hdr.SetMode(os.ModeSymlink | 0777)
+		f, err := w.CreateHeader(hdr)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(target))
+		require.NoError(t, err)
+	}
+
+	r := zipArchive(func(w *zip.Writer) {
+		_, err := w.Create("../evil.txt")
+		require.NoError(t, err)
+	})
+	err = extfs.ImportZip(dst, "dst", r, r.Size())
+	assert.Equal(t, extfs.ErrCrossedBoundary, err)
+
+	r = zipArchive(func(w *zip.Writer) {
+		_, err := w.Create("d/")
+		require.NoError(t, err)
+		zipSymlink(w, "d/l1", "..")
+		zipSymlink(w, "l2", "d/l1/..")
+	})
+	err = extfs.ImportZip(dst, "dst", r, r.Size())
+	assert.Equal(t, extfs.ErrCrossedBoundary, err)
+	_, err = os.Lstat(filepath.Join(dir, "dst", "l2"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExportNotDirectory(t *testing.T) {
+	src := sourceTree(t)
+	err := extfs.ExportTar(src, "src/hello.txt", ioutil.Discard)
+	assert.Error(t, err)
+	err = extfs.ExportZip(src, "missing", ioutil.Discard)
+	assert.True(t, os.IsNotExist(err))
+}
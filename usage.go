@@ -0,0 +1,31 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+// ContentSummary summarizes the content of a file or a directory tree.
+type ContentSummary struct {
+	// Length is the total size in bytes of the files.
+	Length int64
+
+	// FileCount is the number of files, 1 for a file.
+	FileCount int64
+
+	// DirectoryCount is the number of directories including the root
+	// directory itself, 0 for a file.
+	DirectoryCount int64
+
+	// SpaceConsumed is the space used on the underlying storage, including
+	// the replicas. It is -1 when the filesystem cannot tell.
+	SpaceConsumed int64
+}
+
+// DiskUsage is implemented by the filesystems which can summarize the content
+// of a directory tree.
+type DiskUsage interface {
+	// Usage returns the content summary of the named file or directory.
+	Usage(path string) (*ContentSummary, error)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+var errUnknownSize = errors.New("The size of the file is unknown")
+
+// RangeReader reads a remote file through ranged requests. Read streams the
+// content from the offset of the file, opened on the first read after a
+// seek, and each ReadAt opens the range it reads.
+type RangeReader struct {
+	// Path is the path of the file in the errors.
+	Path string
+
+	// Size is the size of the file, negative if it is unknown.
+	Size int64
+
+	// Open returns the content of the file from off, length bytes long or
+	// up to the end if length is negative. It returns io.EOF if off is past
+	// the end of a file of unknown size.
+	Open func(off, length int64) (io.ReadCloser, error)
+
+	offset int64
+	body   io.ReadCloser
+}
+
+// Close closes the content being read, if any.
+func (r *RangeReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+
+	body := r.body
+	r.body = nil
+	return body.Close()
+}
+
+func (r *RangeReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if r.Size >= 0 && r.offset >= r.Size {
+		return 0, io.EOF
+	}
+
+	if r.body == nil {
+		body, err := r.Open(r.offset, -1)
+		if err != nil {
+			return 0, err
+		}
+		r.body = body
+	}
+
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	if err == io.EOF && r.offset < r.Size {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return n, err
+}
+
+func (r *RangeReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, &os.PathError{Op: "readat", Path: r.Path, Err: errors.New("negative offset")}
+	}
+	if r.Size >= 0 && off >= r.Size {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	length := int64(len(p))
+	if r.Size >= 0 && off+length > r.Size {
+		length = r.Size - off
+	}
+
+	body, err := r.Open(off, length)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	n, err := io.ReadFull(body, p[:length])
+	if err == io.ErrUnexpectedEOF && (r.Size < 0 || off+int64(n) >= r.Size) {
+		err = io.EOF
+	}
+	if err == nil && n < len(p) {
+		err = io.EOF
+	}
+
+	return n, err
+}
+
+func (r *RangeReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		if r.Size < 0 {
+			return r.offset, &os.PathError{Op: "seek", Path: r.Path, Err: errUnknownSize}
+		}
+		abs = r.Size + offset
+	default:
+		return r.offset, &os.PathError{Op: "seek", Path: r.Path, Err: os.ErrInvalid}
+	}
+	if abs < 0 {
+		return r.offset, &os.PathError{Op: "seek", Path: r.Path, Err: errors.New("negative position")}
+	}
+
+	if abs != r.offset {
+		r.Close()
+	}
+	r.offset = abs
+
+	return abs, nil
+}
+
+// OpenRange reads length bytes of a file from off, or up to the end of the
+// file if length is negative. get sends the GET request of the file with the
+// Range header. The servers which ignore the range send the whole file, its
+// beginning is skipped.
+func OpenRange(get func(header http.Header) (*http.Response, error), off, length int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	if length >= 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+length-1))
+	} else if off > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", off))
+	}
+
+	resp, err := get(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusPartialContent && off > 0 {
+		if _, err := io.CopyN(ioutil.Discard, resp.Body, off); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+
+	return resp.Body, nil
+}
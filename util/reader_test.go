@@ -0,0 +1,132 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package util
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRangeReader(data string, size int64, opens *int) *RangeReader {
+	return &RangeReader{
+		Path: "/file.txt",
+		Size: size,
+		Open: func(off, length int64) (io.ReadCloser, error) {
+			*opens++
+			if off >= int64(len(data)) {
+				return nil, io.EOF
+			}
+			content := data[off:]
+			if length >= 0 && length < int64(len(content)) {
+				content = content[:length]
+			}
+			return ioutil.NopCloser(bytes.NewReader([]byte(content))), nil
+		},
+	}
+}
+
+func TestRangeReader(t *testing.T) {
+	var opens int
+	r := newRangeReader("Hello world", 11, &opens)
+
+	buf := make([]byte, 5)
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", string(buf[:n]))
+
+	pos, err := r.Seek(1, io.SeekCurrent)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), pos)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(data))
+	assert.Equal(t, 2, opens)
+
+	n, err = r.ReadAt(buf, 8)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "rld", string(buf[:n]))
+	_, err = r.ReadAt(buf, 11)
+	assert.Equal(t, io.EOF, err)
+	_, err = r.ReadAt(buf, -1)
+	assert.Error(t, err)
+
+	pos, err = r.Seek(-5, io.SeekEnd)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), pos)
+	_, err = r.Seek(-1, io.SeekStart)
+	assert.Error(t, err)
+	require.NoError(t, r.Close())
+}
+
+func TestRangeReaderTruncated(t *testing.T) {
+	var opens int
+	r := newRangeReader("Hello", 11, &opens)
+
+	_, err := ioutil.ReadAll(r)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestRangeReaderUnknownSize(t *testing.T) {
+	var opens int
+	r := newRangeReader("Hello world", -1, &opens)
+
+	buf := make([]byte, 8)
+	n, err := r.ReadAt(buf, 6)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "world", string(buf[:n]))
+	_, err = r.ReadAt(buf, 20)
+	assert.Equal(t, io.EOF, err)
+
+	_, err = r.Seek(0, io.SeekEnd)
+	assert.Error(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", string(data))
+
+	_, err = r.Seek(20, io.SeekStart)
+	require.NoError(t, err)
+	n, err = r.Read(buf)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestOpenRange(t *testing.T) {
+	var ranges []string
+	get := func(status int) func(http.Header) (*http.Response, error) {
+		return func(header http.Header) (*http.Response, error) {
+			ranges = append(ranges, header.Get("Range"))
+			body := "Hello world"
+			if status == http.StatusPartialContent {
+				body = "world"
+			}
+			return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}
+	}
+
+	body, err := OpenRange(get(http.StatusPartialContent), 6, 5)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(data))
+
+	body, err = OpenRange(get(http.StatusOK), 6, -1)
+	require.NoError(t, err)
+	data, err = ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(data))
+	assert.Equal(t, []string{"bytes=6-10", "bytes=6-"}, ranges)
+
+	_, err = OpenRange(get(http.StatusOK), 20, -1)
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webdav
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+var errNotDir = errors.New("not a directory")
+
+// StatusError is the error returned when a WebDAV request fails.
+type StatusError struct {
+	Method     string
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("%s: %d %s", e.Method, e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	return fmt.Sprintf("%s: %d %s: %s", e.Method, e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// readError returns the error of a failed response. The servers answer
+// with an HTML page or a DAV:error element, only its text is kept.
+func readError(resp *http.Response) error {
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err != nil {
+		return err
+	}
+
+	msg := strings.TrimSpace(string(data))
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		msg = strings.TrimSpace(msg[:i])
+	}
+
+	return &StatusError{Method: resp.Request.Method, StatusCode: resp.StatusCode, Message: msg}
+}
+
+// interpretError maps the statuses of WebDAV to the errors of the os
+// package. A conflict is the answer to a request whose parent collection
+// does not exist.
+func interpretError(err error) error {
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusNotFound, http.StatusGone, http.StatusConflict:
+		return os.ErrNotExist
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return os.ErrPermission
+	case http.StatusPreconditionFailed:
+		return os.ErrExist
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return extfs.ErrUnsupported
+	case http.StatusInsufficientStorage:
+		return syscall.ENOSPC
+	default:
+		return err
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webdav
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+var errClosed = errors.New("WebDAV file already closed")
+
+// file is a file opened either for reading or for writing. A reader sends
+// a ranged GET from its offset on the first read, a writer streams the data
+// of a PUT until it is closed.
+type file struct {
+	fs       *webdav
+	name     string
+	fullpath string
+	closed   bool
+
+	// Readers
+	reader *util.RangeReader
+
+	// Writers
+	pipe *io.PipeWriter
+	done chan error
+}
+
+func newReader(fs *webdav, name, fullpath string, size int64) *file {
+	open := func(off, length int64) (io.ReadCloser, error) {
+		body, err := util.OpenRange(func(header http.Header) (*http.Response, error) {
+			return fs.request(http.MethodGet, fullpath, header, nil)
+		}, off, length)
+		if err != nil {
+			return nil, &os.PathError{Op: "read", Path: fullpath, Err: interpretError(err)}
+		}
+		return body, nil
+	}
+
+	return &file{fs: fs, name: name, fullpath: fullpath, reader: &util.RangeReader{Path: fullpath, Size: size, Open: open}}
+}
+
+func newWriter(fs *webdav, name, fullpath string, exclusive bool) *file {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := fs.upload(fullpath, pr, exclusive)
+		pr.CloseWithError(err)
+		done <- err
+	}()
+
+	return &file{fs: fs, name: name, fullpath: fullpath, pipe: pw, done: done}
+}
+
+func (f *file) Close() error {
+	if f.closed {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: errClosed}
+	}
+	f.closed = true
+
+	if f.reader != nil {
+		return f.reader.Close()
+	}
+
+	f.pipe.Close()
+	if err := <-f.done; err != nil {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Read(p)
+}
+
+func (f *file) ReadAt(p []byte, off int64) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.ReadAt(p, off)
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrUnsupported
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "seek", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	if f.reader != nil {
+		return 0, extfs.ErrReadOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "write", Path: f.fullpath, Err: errClosed}
+	}
+
+	n, err := f.pipe.Write(p)
+	if err != nil {
+		return n, &os.PathError{Op: "write", Path: f.fullpath, Err: interpretError(err)}
+	}
+
+	return n, nil
+}
+
+func (f *file) WriteAt(p []byte, off int64) (int, error) {
+	return 0, extfs.ErrUnsupported
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+func (f *file) Stat() (os.FileInfo, error) {
+	return f.fs.stat(f.fullpath)
+}
+
+func (f *file) Sync() error {
+	if f.reader == nil {
+		return extfs.ErrUnsupported
+	}
+
+	return nil
+}
+
+func (f *file) Truncate(size int64) error {
+	return extfs.ErrUnsupported
+}
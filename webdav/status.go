@@ -0,0 +1,186 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webdav
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultFileMode      = 0644
+	defaultDirectoryMode = 0755
+)
+
+// propfindBody asks for the properties the file infos are made of.
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<D:propfind xmlns:D="DAV:"><D:prop>
+<D:resourcetype/><D:getcontentlength/><D:getlastmodified/><D:getetag/><D:getcontenttype/>
+</D:prop></D:propfind>`
+
+// Resource is the properties of a file returned by PROPFIND. It is the
+// Sys() of the os.FileInfo of the filesystem.
+type Resource struct {
+	Path          string
+	Collection    bool
+	ContentLength int64
+	LastModified  time.Time
+	ETag          string
+	ContentType   string
+}
+
+type multistatus struct {
+	Responses []struct {
+		Href      string `xml:"DAV: href"`
+		Status    string `xml:"DAV: status"`
+		Propstats []struct {
+			Status string `xml:"DAV: status"`
+			Prop   struct {
+				ResourceType struct {
+					Collection *struct{} `xml:"DAV: collection"`
+				} `xml:"DAV: resourcetype"`
+				ContentLength string `xml:"DAV: getcontentlength"`
+				LastModified  string `xml:"DAV: getlastmodified"`
+				ETag          string `xml:"DAV: getetag"`
+				ContentType   string `xml:"DAV: getcontenttype"`
+			} `xml:"DAV: prop"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+// readResources decodes the multistatus response of a PROPFIND. The paths
+// of the resources are the decoded paths of their hrefs, without the
+// trailing slash of the collections.
+func readResources(r io.Reader) ([]*Resource, error) {
+	var ms multistatus
+	if err := xml.NewDecoder(r).Decode(&ms); err != nil {
+		return nil, err
+	}
+
+	resources := make([]*Resource, 0, len(ms.Responses))
+	for _, resp := range ms.Responses {
+		if resp.Status != "" && statusCode(resp.Status) != http.StatusOK {
+			continue
+		}
+
+		u, err := url.Parse(resp.Href)
+		if err != nil {
+			return nil, err
+		}
+		res := &Resource{Path: u.Path}
+		if res.Path != "/" {
+			res.Path = strings.TrimSuffix(res.Path, "/")
+		}
+
+		for _, ps := range resp.Propstats {
+			if statusCode(ps.Status) != http.StatusOK {
+				continue
+			}
+
+			prop := ps.Prop
+			if prop.ResourceType.Collection != nil {
+				res.Collection = true
+			}
+			if prop.ContentLength != "" {
+				res.ContentLength, _ = strconv.ParseInt(strings.TrimSpace(prop.ContentLength), 10, 64)
+			}
+			if prop.LastModified != "" {
+				res.LastModified, _ = http.ParseTime(strings.TrimSpace(prop.LastModified))
+			}
+			if prop.ETag != "" {
+				res.ETag = prop.ETag
+			}
+			if prop.ContentType != "" {
+				res.ContentType = prop.ContentType
+			}
+		}
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+// readPropstatStatus decodes the multistatus response of a PROPPATCH and
+// returns the first status which is not a success, or else 200.
+func readPropstatStatus(r io.Reader) (int, error) {
+	var ms multistatus
+	if err := xml.NewDecoder(r).Decode(&ms); err != nil {
+		return 0, err
+	}
+
+	for _, resp := range ms.Responses {
+		if resp.Status != "" && statusCode(resp.Status) != http.StatusOK {
+			return statusCode(resp.Status), nil
+		}
+		for _, ps := range resp.Propstats {
+			if code := statusCode(ps.Status); code != http.StatusOK {
+				return code, nil
+			}
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// statusCode returns the code of a status line such as HTTP/1.1 200 OK.
+func statusCode(status string) int {
+	fields := strings.Fields(status)
+	if len(fields) < 2 {
+		return 0
+	}
+
+	code, _ := strconv.Atoi(fields[1])
+	return code
+}
+
+type fileInfo struct {
+	name string
+	res  *Resource
+}
+
+func newFileInfo(res *Resource) *fileInfo {
+	return &fileInfo{name: path.Base(res.Path), res: res}
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	if fi.res.Collection {
+		return 0
+	}
+
+	return fi.res.ContentLength
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	if fi.res.Collection {
+		return os.ModeDir | defaultDirectoryMode
+	}
+
+	return defaultFileMode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	return fi.res.LastModified
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.res.Collection
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return fi.res
+}
@@ -0,0 +1,470 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package webdav implements a filesystem over a WebDAV server, as defined
+// by RFC 4918.
+package webdav
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+const (
+	defaultPort       = "80"
+	defaultSecurePort = "443"
+)
+
+// webdav is a filesystem based on a WebDAV server.
+type webdav struct {
+	client   *http.Client
+	endpoint *url.URL
+	user     string
+	password string
+	base     string
+}
+
+// New returns a WebDAV filesystem. The endpoint is the URL of the root of
+// the server, for example https://cloud.example.com/remote.php/dav. The
+// requests are authenticated with the user and the password of the
+// configuration, if any.
+func New(endpoint, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("Unsupported WebDAV scheme %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, errors.New("WebDAV endpoint has no host")
+	}
+	if u.Port() == "" {
+		port := defaultPort
+		if u.Scheme == "https" {
+			port = defaultSecurePort
+		}
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       cfg.TLSConfig,
+		ResponseHeaderTimeout: cfg.RPCTimeout,
+	}
+
+	return &webdav{
+		client:   &http.Client{Transport: transport},
+		endpoint: &url.URL{Scheme: u.Scheme, Host: u.Host, Path: strings.TrimSuffix(u.Path, "/")},
+		user:     cfg.User,
+		password: cfg.Password,
+		base:     baseDir,
+	}, nil
+}
+
+func (fs *webdav) Create(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+}
+
+func (fs *webdav) Open(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, os.O_RDONLY)
+}
+
+// OpenFile opens a file either for reading or for writing. A PUT replaces
+// the whole file, so an existing file can only be truncated. The
+// permissions are ignored.
+func (fs *webdav) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath, flag)
+}
+
+// Remove removes a file or an empty directory. The DELETE of a collection
+// removes its members, so the directory is listed first.
+func (fs *webdav) Remove(filename string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return err
+	}
+
+	resources, err := fs.propfind(fullpath, "1")
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+	if len(resources) > 1 {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: syscall.ENOTEMPTY}
+	}
+
+	err = fs.delete(fullpath)
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *webdav) RemoveAll(path string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	err = fs.delete(fullpath)
+	if err != nil && !os.IsNotExist(interpretError(err)) {
+		return &os.PathError{Op: "remove", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+// Rename moves a file or a directory with MOVE, which replaces an existing
+// destination.
+func (fs *webdav) Rename(oldpath, newpath string) error {
+	var err error
+	oldpath, err = util.UnderlyingPath(fs.base, oldpath)
+	if err != nil {
+		return err
+	}
+
+	newpath, err = util.UnderlyingPath(fs.base, newpath)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{
+		"Destination": {fs.url(newpath)},
+		"Overwrite":   {"T"},
+	}
+	err = fs.call("MOVE", oldpath, header, nil)
+	if err != nil {
+		// Some servers forbid the move of a missing file.
+		if _, serr := fs.stat(oldpath); os.IsNotExist(serr) {
+			err = &StatusError{Method: "MOVE", StatusCode: http.StatusNotFound}
+		}
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *webdav) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.stat(fullpath)
+}
+
+func (fs *webdav) ReadDir(path string) ([]os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := fs.propfind(fullpath, "1")
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretError(err)}
+	}
+
+	// The response describes the collection itself along with its members.
+	self := fs.urlPath(fullpath)
+	fis := make([]os.FileInfo, 0, len(resources))
+	for _, res := range resources {
+		if res.Path == self {
+			if !res.Collection {
+				return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: errNotDir}
+			}
+			continue
+		}
+		fis = append(fis, newFileInfo(res))
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+func (fs *webdav) MkdirAll(path string, perm os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	return fs.mkdirAll(fullpath)
+}
+
+// Chmod returns extfs.ErrUnsupported, WebDAV has no permissions.
+func (fs *webdav) Chmod(name string, mode os.FileMode) error {
+	return extfs.ErrUnsupported
+}
+
+// Chtimes sets the modification time of a file with a PROPPATCH of
+// getlastmodified. Most of the servers protect this property, they make
+// Chtimes return extfs.ErrUnsupported. The access time is ignored.
+func (fs *webdav) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	err = fs.proppatch(fullpath, mtime)
+	if err != nil {
+		return &os.PathError{Op: "chtimes", Path: fullpath, Err: interpretError(err)}
+	}
+
+	return nil
+}
+
+func (fs *webdav) Close() error {
+	fs.client.CloseIdleConnections()
+	return nil
+}
+
+func (fs *webdav) openFile(name, fullpath string, flag int) (extfs.File, error) {
+	accMode := flag & syscall.O_ACCMODE
+	if accMode == os.O_RDWR {
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: extfs.ErrUnsupported}
+	}
+
+	fi, err := fs.stat(fullpath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if accMode == os.O_RDONLY {
+		if !exists {
+			return nil, err
+		}
+		if fi.IsDir() {
+			return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+		}
+		return newReader(fs, name, fullpath, fi.Size()), nil
+	}
+
+	switch {
+	case exists && flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrExist}
+	case !exists && flag&os.O_CREATE == 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrNotExist}
+	case exists && fi.IsDir():
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: syscall.EISDIR}
+	case !exists:
+		if err := fs.mkdirAll(path.Dir(fullpath)); err != nil {
+			return nil, err
+		}
+		return newWriter(fs, name, fullpath, flag&os.O_EXCL != 0), nil
+	case flag&os.O_TRUNC != 0:
+		return newWriter(fs, name, fullpath, false), nil
+	default:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: extfs.ErrUnsupported}
+	}
+}
+
+func (fs *webdav) stat(fullpath string) (os.FileInfo, error) {
+	resources, err := fs.propfind(fullpath, "0")
+	if err == nil && len(resources) == 0 {
+		err = errors.New("WebDAV returned no properties")
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretError(err)}
+	}
+
+	fi := newFileInfo(resources[0])
+	fi.name = path.Base(fullpath)
+	return fi, nil
+}
+
+// mkdirAll creates the missing collections of a path. MKCOL fails with a
+// conflict when the parent does not exist, and is not allowed when the
+// path already does.
+func (fs *webdav) mkdirAll(fullpath string) error {
+	err := fs.call("MKCOL", fullpath+"/", nil, nil)
+	if err == nil || fullpath == "/" {
+		return nil
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusMethodNotAllowed:
+			fi, serr := fs.stat(fullpath)
+			if serr != nil {
+				return serr
+			}
+			if !fi.IsDir() {
+				return &os.PathError{Op: "mkdir", Path: fullpath, Err: errNotDir}
+			}
+			return nil
+
+		case http.StatusConflict:
+			if err := fs.mkdirAll(path.Dir(fullpath)); err != nil {
+				return err
+			}
+			return fs.mkdirAll(fullpath)
+		}
+	}
+
+	return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretError(err)}
+}
+
+func (fs *webdav) delete(fullpath string) error {
+	return fs.call(http.MethodDelete, fullpath, nil, nil)
+}
+
+// propfind returns the properties of a file, and of the members of a
+// collection when depth is 1.
+func (fs *webdav) propfind(fullpath, depth string) ([]*Resource, error) {
+	header := http.Header{
+		"Depth":        {depth},
+		"Content-Type": {"application/xml; charset=utf-8"},
+	}
+	resp, err := fs.request("PROPFIND", fullpath, header, strings.NewReader(propfindBody))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, &StatusError{Method: "PROPFIND", StatusCode: resp.StatusCode, Message: "not a multistatus response"}
+	}
+
+	return readResources(resp.Body)
+}
+
+// proppatch sets the getlastmodified property of a file. The multistatus
+// response tells whether the server accepted it.
+func (fs *webdav) proppatch(fullpath string, mtime time.Time) error {
+	body := `<?xml version="1.0" encoding="utf-8"?>
+<D:propertyupdate xmlns:D="DAV:"><D:set><D:prop>
+<D:getlastmodified>` + mtime.UTC().Format(http.TimeFormat) + `</D:getlastmodified>
+</D:prop></D:set></D:propertyupdate>`
+
+	header := http.Header{"Content-Type": {"application/xml; charset=utf-8"}}
+	resp, err := fs.request("PROPPATCH", fullpath, header, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+
+	code, err := readPropstatStatus(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch code {
+	case http.StatusOK:
+		return nil
+	case http.StatusForbidden, http.StatusConflict:
+		// The property is protected.
+		return extfs.ErrUnsupported
+	default:
+		return &StatusError{Method: "PROPPATCH", StatusCode: code}
+	}
+}
+
+// upload sends the content of a file with PUT. If exclusive, the server is
+// asked not to replace an existing file.
+func (fs *webdav) upload(fullpath string, body io.Reader, exclusive bool) error {
+	header := http.Header{"Content-Type": {"application/octet-stream"}}
+	if exclusive {
+		header.Set("If-None-Match", "*")
+	}
+
+	return fs.call(http.MethodPut, fullpath, header, body)
+}
+
+// urlPath returns the path of a file in the URLs of the server.
+func (fs *webdav) urlPath(fullpath string) string {
+	p := fs.endpoint.Path + fullpath
+	if p != "/" {
+		p = strings.TrimSuffix(p, "/")
+	}
+
+	return p
+}
+
+// url returns the URL of a file.
+func (fs *webdav) url(fullpath string) string {
+	u := *fs.endpoint
+	u.Path = fs.endpoint.Path + fullpath
+	return u.String()
+}
+
+// request sends a request. The response is a *StatusError if its status is
+// not a success.
+func (fs *webdav) request(method, fullpath string, header http.Header, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, fs.url(fullpath), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if fs.user != "" {
+		req.SetBasicAuth(fs.user, fs.password)
+	}
+
+	resp, err := fs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		return nil, readError(resp)
+	}
+
+	return resp, nil
+}
+
+// call sends a request and discards its response.
+func (fs *webdav) call(method, fullpath string, header http.Header, body io.Reader) error {
+	resp, err := fs.request(method, fullpath, header, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(ioutil.Discard, resp.Body)
+	return err
+}
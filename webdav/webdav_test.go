@@ -0,0 +1,169 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webdav
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/extfstest"
+	"github.com/rkcloudchain/extfs/webdav/webdavtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUser     = "cloudchain"
+	testPassword = "secret"
+)
+
+var server *webdavtest.Server
+
+func TestMain(m *testing.M) {
+	extfstest.Main(m, func() (func(), error) {
+		var err error
+		server, err = webdavtest.NewServer(testUser, testPassword)
+		if err != nil {
+			return nil, err
+		}
+		return func() { server.Close() }, nil
+	})
+}
+
+func newFilesystem(t *testing.T, base string) extfs.Filesystem {
+	fs, err := New(server.URL(), base, &extfs.Config{User: testUser, Password: testPassword})
+	require.NoError(t, err)
+
+	return fs
+}
+
+func TestFilesystem(t *testing.T) {
+	extfstest.Test(t, extfstest.Config{New: newFilesystem})
+}
+
+func TestCreate(t *testing.T) {
+	fs := newFilesystem(t, "/test1")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "dir/my file.txt", []byte("Hello world"))
+	data, err := ioutil.ReadFile(filepath.Join(server.Dir(), "test1/dir/my file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", string(data))
+
+	fi, err := fs.Stat("dir/my file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "/test1/dir/my file.txt", fi.Sys().(*Resource).Path)
+
+	_, err = fs.Open("dir")
+	assert.Equal(t, syscall.EISDIR, err.(*os.PathError).Err)
+}
+
+func TestOpenFile(t *testing.T) {
+	fs := newFilesystem(t, "/test3")
+	defer fs.Close()
+
+	f, err := fs.OpenFile("private.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("x"), 0)
+	assert.Equal(t, extfs.ErrUnsupported, err)
+	assert.Equal(t, extfs.ErrUnsupported, f.Sync())
+	require.NoError(t, f.Close())
+
+	_, err = fs.OpenFile("private.txt", os.O_RDWR, 0)
+	assert.Equal(t, extfs.ErrUnsupported, err.(*os.PathError).Err)
+
+	f, err = fs.Open("private.txt")
+	require.NoError(t, err)
+	assert.Equal(t, extfs.ErrUnsupported, f.Truncate(0))
+	require.NoError(t, f.Close())
+}
+
+func TestDirectories(t *testing.T) {
+	fs := newFilesystem(t, "/test4")
+	defer fs.Close()
+
+	mkcols := server.Requests("MKCOL")
+	require.NoError(t, fs.MkdirAll("a/b/c", 0700))
+	require.NoError(t, fs.MkdirAll("a/b", 0700))
+	assert.True(t, server.Requests("MKCOL") > mkcols)
+
+	extfstest.WriteFile(t, fs, "a/z.txt", []byte("z"))
+	_, err := fs.ReadDir("a/z.txt")
+	assert.Error(t, err)
+
+	err = fs.Remove("a/b")
+	assert.Equal(t, syscall.ENOTEMPTY, err.(*os.PathError).Err)
+}
+
+func TestChange(t *testing.T) {
+	fs := newFilesystem(t, "/test6")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+	mtime := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// The handler of x/net/webdav protects getlastmodified.
+	err := fs.Chtimes("myfile.txt", mtime, mtime)
+	assert.Equal(t, extfs.ErrUnsupported, err.(*os.PathError).Err)
+
+	server.AllowModTimes(true)
+	defer server.AllowModTimes(false)
+	require.NoError(t, fs.Chtimes("myfile.txt", mtime, mtime))
+
+	fi, err := fs.Stat("myfile.txt")
+	require.NoError(t, err)
+	assert.True(t, mtime.Equal(fi.ModTime()))
+
+	err = fs.Chtimes("missing.txt", mtime, mtime)
+	assert.True(t, os.IsNotExist(err))
+
+	assert.Equal(t, extfs.ErrUnsupported, fs.Chmod("myfile.txt", 0600))
+}
+
+func TestAuthentication(t *testing.T) {
+	fs, err := New(server.URL(), "/", &extfs.Config{User: testUser, Password: "wrong"})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	_, err = fs.Stat("/")
+	assert.True(t, os.IsPermission(err))
+
+	_, err = New("ftp://"+strings.TrimPrefix(server.URL(), "http://"), "/", nil)
+	assert.Error(t, err)
+}
+
+func TestTLS(t *testing.T) {
+	server, err := webdavtest.NewTLSServer("", "")
+	require.NoError(t, err)
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	fs, err := New(server.URL()+"/dav/", "/data", &extfs.Config{TLSConfig: &tls.Config{RootCAs: pool}})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "myfile.txt")))
+
+	data, err := ioutil.ReadFile(filepath.Join(server.Dir(), "dav/data/myfile.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", string(data))
+
+	fis, err := fs.ReadDir("/")
+	require.NoError(t, err)
+	require.Len(t, fis, 1)
+	assert.Equal(t, "myfile.txt", fis[0].Name())
+}
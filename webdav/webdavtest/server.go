@@ -0,0 +1,211 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package webdavtest provides an in-process WebDAV server for the tests. It
+// serves a temporary directory with the handler of golang.org/x/net/webdav,
+// behind a basic authentication. Like Apache, it refuses the PUT requests
+// with If-None-Match: * on existing files, and it can accept the PROPPATCH
+// of getlastmodified which the handler protects.
+package webdavtest
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/webdav"
+)
+
+// Server is an in-process WebDAV server.
+type Server struct {
+	srv      *httptest.Server
+	handler  *webdav.Handler
+	user     string
+	password string
+	dir      string
+
+	mu       sync.Mutex
+	requests map[string]int
+	modTimes bool
+}
+
+// NewServer starts a WebDAV server which accepts the user with its
+// password, or anonymous requests if user is empty.
+func NewServer(user, password string) (*Server, error) {
+	return newServer(user, password, false)
+}
+
+// NewTLSServer starts a WebDAV server over HTTPS, with a self-signed
+// certificate.
+func NewTLSServer(user, password string) (*Server, error) {
+	return newServer(user, password, true)
+}
+
+func newServer(user, password string, secure bool) (*Server, error) {
+	dir, err := ioutil.TempDir("", "webdavtest")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		handler: &webdav.Handler{
+			FileSystem: webdav.Dir(dir),
+			LockSystem: webdav.NewMemLS(),
+		},
+		user:     user,
+		password: password,
+		dir:      dir,
+		requests: make(map[string]int),
+	}
+	if secure {
+		s.srv = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	} else {
+		s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	}
+
+	return s, nil
+}
+
+// URL returns the root of the server, such as http://127.0.0.1:8080.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Dir returns the directory served as the root of the server.
+func (s *Server) Dir() string {
+	return s.dir
+}
+
+// Certificate returns the certificate of a TLS server.
+func (s *Server) Certificate() *x509.Certificate {
+	return s.srv.Certificate()
+}
+
+// Requests returns the number of requests of a method, such as PROPFIND,
+// the server received.
+func (s *Server) Requests(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[method]
+}
+
+// AllowModTimes sets whether the PROPPATCH requests may set the
+// getlastmodified property, which changes the modification time of the
+// file.
+func (s *Server) AllowModTimes(allow bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.modTimes = allow
+}
+
+// Close stops the server and removes its directory.
+func (s *Server) Close() error {
+	s.srv.Close()
+	return os.RemoveAll(s.dir)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests[r.Method]++
+	modTimes := s.modTimes
+	s.mu.Unlock()
+
+	if s.user != "" {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != s.user || password != s.password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="webdavtest"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodPut && r.Header.Get("If-None-Match") == "*":
+		if _, err := os.Stat(s.localPath(r.URL.Path)); err == nil {
+			http.Error(w, "Precondition Failed", http.StatusPreconditionFailed)
+			return
+		}
+
+	case r.Method == "PROPPATCH" && modTimes:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if s.setModTime(w, r, data) {
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+	}
+
+	s.handler.ServeHTTP(w, r)
+}
+
+// setModTime handles a PROPPATCH which only sets getlastmodified. It
+// returns false for the other ones.
+func (s *Server) setModTime(w http.ResponseWriter, r *http.Request, data []byte) bool {
+	var update struct {
+		Set []struct {
+			Prop struct {
+				Any []struct {
+					XMLName xml.Name
+					Value   string `xml:",chardata"`
+				} `xml:",any"`
+			} `xml:"DAV: prop"`
+		} `xml:"DAV: set"`
+		Remove []struct{} `xml:"DAV: remove"`
+	}
+	if xml.Unmarshal(data, &update) != nil || len(update.Set) != 1 || len(update.Remove) != 0 {
+		return false
+	}
+	props := update.Set[0].Prop.Any
+	if len(props) != 1 || props[0].XMLName != (xml.Name{Space: "DAV:", Local: "getlastmodified"}) {
+		return false
+	}
+
+	mtime, err := http.ParseTime(strings.TrimSpace(props[0].Value))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return true
+	}
+	if err := os.Chtimes(s.localPath(r.URL.Path), mtime, mtime); err != nil {
+		if os.IsNotExist(err) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return true
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?>
+<D:multistatus xmlns:D="DAV:"><D:response><D:href>%s</D:href>
+<D:propstat><D:prop><D:getlastmodified/></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat>
+</D:response></D:multistatus>`, escapeXML(r.URL.EscapedPath()))
+	return true
+}
+
+func (s *Server) localPath(p string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(path.Clean("/"+p)))
+}
+
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,82 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"encoding/hex"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// md5md5crc32cLength is the length of a serialized MD5MD5CRC32C checksum:
+// the bytes per CRC, the CRCs per block and the MD5.
+const md5md5crc32cLength = 28
+
+// Checksum returns the MD5MD5CRC32C checksum computed by the datanodes. The
+// other algorithms, and the checksums of the clusters using CRC32, are
+// computed by reading the file.
+func (fs *webhdfs) Checksum(path string, algorithm extfs.ChecksumAlgorithm) (*extfs.FileChecksum, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if algorithm == extfs.MD5MD5CRC32C {
+		sum, err := fs.fileChecksum(fullpath)
+		if err != nil {
+			return nil, err
+		}
+		if sum != nil {
+			return &extfs.FileChecksum{Algorithm: algorithm, Sum: sum}, nil
+		}
+	}
+
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := fs.open(fullpath, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return extfs.ComputeChecksum(body, algorithm, fi.(*fileInfo).status.BlockSize)
+}
+
+// fileChecksum returns the MD5 of the MD5MD5CRC32C checksum of the
+// datanodes, or nil if they use another algorithm.
+func (fs *webhdfs) fileChecksum(fullpath string) ([]byte, error) {
+	var resp struct {
+		FileChecksum struct {
+			Algorithm string `json:"algorithm"`
+			Bytes     string `json:"bytes"`
+			Length    int    `json:"length"`
+		} `json:"FileChecksum"`
+	}
+	err := fs.call(http.MethodGet, "GETFILECHECKSUM", fullpath, nil, &resp)
+	if err != nil {
+		return nil, &os.PathError{Op: "checksum", Path: fullpath, Err: interpretException(err)}
+	}
+
+	checksum := resp.FileChecksum
+	if checksum.Length != md5md5crc32cLength || !strings.HasSuffix(checksum.Algorithm, "CRC32C") {
+		return nil, nil
+	}
+
+	data, err := hex.DecodeString(checksum.Bytes)
+	if err != nil || len(data) != md5md5crc32cLength {
+		return nil, nil
+	}
+
+	return data[md5md5crc32cLength-16:], nil
+}
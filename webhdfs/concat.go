@@ -0,0 +1,52 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/rkcloudchain/extfs/util"
+)
+
+// Concat moves the blocks of the sources to the target without copying
+// them. The namenode checks that the files can be concatenated.
+func (fs *webhdfs) Concat(target string, sources []string) error {
+	trg, err := util.UnderlyingPath(fs.base, target)
+	if err != nil {
+		return err
+	}
+
+	srcs := make([]string, 0, len(sources))
+	seen := map[string]bool{trg: true}
+	for _, source := range sources {
+		src, err := util.UnderlyingPath(fs.base, source)
+		if err != nil {
+			return err
+		}
+		if seen[src] {
+			return &os.PathError{Op: "concat", Path: src, Err: errors.New("Duplicate concat source")}
+		}
+		seen[src] = true
+
+		srcs = append(srcs, src)
+	}
+
+	if len(srcs) == 0 {
+		return nil
+	}
+
+	err = fs.call(http.MethodPost, "CONCAT", trg, url.Values{"sources": {strings.Join(srcs, ",")}}, nil)
+	if err != nil {
+		return &os.PathError{Op: "concat", Path: trg, Err: interpretException(err)}
+	}
+
+	return nil
+}
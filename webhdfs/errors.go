@@ -0,0 +1,108 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+const (
+	fileNotFoundException        = "FileNotFoundException"
+	accessControlException       = "AccessControlException"
+	securityException            = "SecurityException"
+	invalidTokenException        = "InvalidToken"
+	pathIsNotEmptyDirException   = "PathIsNotEmptyDirectoryException"
+	fileAlreadyExistsException   = "FileAlreadyExistsException"
+	alreadyBeingCreatedException = "AlreadyBeingCreatedException"
+	illegalArgumentException     = "IllegalArgumentException"
+	unsupportedException         = "UnsupportedOperationException"
+)
+
+// RemoteException is the error returned by WebHDFS when a request fails.
+type RemoteException struct {
+	Exception     string `json:"exception"`
+	JavaClassName string `json:"javaClassName"`
+	Message       string `json:"message"`
+
+	// StatusCode is the HTTP status of the response.
+	StatusCode int `json:"-"`
+}
+
+func (e *RemoteException) Error() string {
+	if e.Message == "" {
+		return e.Exception
+	}
+
+	return fmt.Sprintf("%s: %s", e.Exception, e.Message)
+}
+
+// readRemoteException decodes the error of a failed response. The proxies
+// which do not answer in JSON give an exception named after the status.
+func readRemoteException(resp *http.Response) error {
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	var body struct {
+		RemoteException *RemoteException `json:"RemoteException"`
+	}
+	if json.Unmarshal(data, &body) != nil || body.RemoteException == nil {
+		e := &RemoteException{Message: strings.TrimSpace(string(data)), StatusCode: resp.StatusCode}
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			e.Exception = fileNotFoundException
+		case http.StatusUnauthorized, http.StatusForbidden:
+			e.Exception = accessControlException
+		default:
+			e.Exception = http.StatusText(resp.StatusCode)
+		}
+		return e
+	}
+
+	body.RemoteException.StatusCode = resp.StatusCode
+	return body.RemoteException
+}
+
+func interpretException(err error) error {
+	var remoteErr *RemoteException
+	if !errors.As(err, &remoteErr) {
+		return err
+	}
+
+	exception := remoteErr.Exception
+	if i := strings.LastIndexAny(exception, ".$"); i >= 0 {
+		exception = exception[i+1:]
+	}
+
+	switch exception {
+	case fileNotFoundException:
+		return os.ErrNotExist
+	case accessControlException, securityException, invalidTokenException:
+		return os.ErrPermission
+	case pathIsNotEmptyDirException:
+		return syscall.ENOTEMPTY
+	case fileAlreadyExistsException, alreadyBeingCreatedException:
+		return os.ErrExist
+	case illegalArgumentException:
+		return os.ErrInvalid
+	case unsupportedException:
+		return extfs.ErrUnsupported
+	default:
+		return err
+	}
+}
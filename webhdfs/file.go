@@ -0,0 +1,146 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+var errClosed = errors.New("WebHDFS file already closed")
+
+// file is a file opened either for reading or for writing. A reader opens
+// a stream from its offset on the first read, a writer streams the data to
+// the datanode until it is closed.
+type file struct {
+	fs       *webhdfs
+	name     string
+	fullpath string
+	closed   bool
+
+	// Readers
+	reader *util.RangeReader
+
+	// Writers
+	pipe *io.PipeWriter
+	done chan error
+}
+
+func newReader(fs *webhdfs, name, fullpath string, size int64) *file {
+	open := func(off, length int64) (io.ReadCloser, error) {
+		return fs.open(fullpath, off, length)
+	}
+
+	return &file{fs: fs, name: name, fullpath: fullpath, reader: &util.RangeReader{Path: fullpath, Size: size, Open: open}}
+}
+
+func newWriter(fs *webhdfs, name, fullpath, method, location string) *file {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := fs.upload(method, location, pr)
+		pr.CloseWithError(err)
+		done <- err
+	}()
+
+	return &file{fs: fs, name: name, fullpath: fullpath, pipe: pw, done: done}
+}
+
+func (f *file) Close() error {
+	if f.closed {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: errClosed}
+	}
+	f.closed = true
+
+	if f.reader != nil {
+		return f.reader.Close()
+	}
+
+	f.pipe.Close()
+	if err := <-f.done; err != nil {
+		return &os.PathError{Op: "close", Path: f.fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Read(p)
+}
+
+func (f *file) ReadAt(p []byte, off int64) (int, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrWriteOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "read", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.ReadAt(p, off)
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	if f.reader == nil {
+		return 0, extfs.ErrUnsupported
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "seek", Path: f.fullpath, Err: errClosed}
+	}
+
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	if f.reader != nil {
+		return 0, extfs.ErrReadOnly
+	}
+	if f.closed {
+		return 0, &os.PathError{Op: "write", Path: f.fullpath, Err: errClosed}
+	}
+
+	n, err := f.pipe.Write(p)
+	if err != nil {
+		return n, &os.PathError{Op: "write", Path: f.fullpath, Err: interpretException(err)}
+	}
+
+	return n, nil
+}
+
+func (f *file) WriteAt(p []byte, off int64) (int, error) {
+	return 0, extfs.ErrUnsupported
+}
+
+func (f *file) Name() string {
+	return f.name
+}
+
+func (f *file) Stat() (os.FileInfo, error) {
+	return f.fs.stat(f.fullpath)
+}
+
+func (f *file) Sync() error {
+	if f.reader == nil {
+		return extfs.ErrUnsupported
+	}
+
+	return nil
+}
+
+func (f *file) Truncate(size int64) error {
+	return extfs.ErrUnsupported
+}
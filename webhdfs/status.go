@@ -0,0 +1,97 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"os"
+	"path"
+	"strconv"
+	"time"
+)
+
+// FileStatus is the status of a file returned by WebHDFS. It is the Sys()
+// of the os.FileInfo of the filesystem.
+type FileStatus struct {
+	AccessTime       int64  `json:"accessTime"`
+	BlockSize        int64  `json:"blockSize"`
+	Group            string `json:"group"`
+	Length           int64  `json:"length"`
+	ModificationTime int64  `json:"modificationTime"`
+	Owner            string `json:"owner"`
+	PathSuffix       string `json:"pathSuffix"`
+	Permission       string `json:"permission"`
+	Replication      int    `json:"replication"`
+	Type             string `json:"type"`
+}
+
+type fileInfo struct {
+	name   string
+	status *FileStatus
+}
+
+// newFileInfo returns the file info of a status. The path suffix is empty
+// when the status is the one of the requested path.
+func newFileInfo(status *FileStatus, fullpath string) *fileInfo {
+	name := status.PathSuffix
+	if name == "" {
+		name = path.Base(fullpath)
+	}
+
+	return &fileInfo{name: name, status: status}
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	return fi.status.Length
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	perm, _ := strconv.ParseUint(fi.status.Permission, 8, 32)
+	mode := os.FileMode(perm) & os.ModePerm
+	if perm&01000 != 0 {
+		mode |= os.ModeSticky
+	}
+
+	switch fi.status.Type {
+	case "DIRECTORY":
+		mode |= os.ModeDir
+	case "SYMLINK":
+		mode |= os.ModeSymlink
+	}
+
+	return mode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	return time.Unix(0, fi.status.ModificationTime*int64(time.Millisecond))
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.status.Type == "DIRECTORY"
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return fi.status
+}
+
+// Owner returns the name of the user owning the file.
+func (fi *fileInfo) Owner() string {
+	return fi.status.Owner
+}
+
+// Group returns the name of the group owning the file.
+func (fi *fileInfo) Group() string {
+	return fi.status.Group
+}
+
+// AccessTime returns the last access time of the file.
+func (fi *fileInfo) AccessTime() time.Time {
+	return time.Unix(0, fi.status.AccessTime*int64(time.Millisecond))
+}
@@ -0,0 +1,73 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TokenManager is implemented by the WebHDFS filesystem to manage the
+// delegation tokens, which authenticate the requests in place of a user
+// name or of kerberos.
+type TokenManager interface {
+	// GetDelegationToken returns a new delegation token, in its URL-safe
+	// encoding, which the renewer is allowed to renew.
+	GetDelegationToken(renewer string) (string, error)
+
+	// RenewDelegationToken renews a delegation token and returns its new
+	// expiration time.
+	RenewDelegationToken(token string) (time.Time, error)
+
+	// CancelDelegationToken cancels a delegation token.
+	CancelDelegationToken(token string) error
+}
+
+func (fs *webhdfs) GetDelegationToken(renewer string) (string, error) {
+	var params url.Values
+	if renewer != "" {
+		params = url.Values{"renewer": {renewer}}
+	}
+
+	var resp struct {
+		Token struct {
+			URLString string `json:"urlString"`
+		} `json:"Token"`
+	}
+	err := fs.call(http.MethodGet, "GETDELEGATIONTOKEN", "/", params, &resp)
+	if err == nil && resp.Token.URLString == "" {
+		err = errors.New("WebHDFS returned no delegation token")
+	}
+	if err != nil {
+		return "", interpretException(err)
+	}
+
+	return resp.Token.URLString, nil
+}
+
+func (fs *webhdfs) RenewDelegationToken(token string) (time.Time, error) {
+	var resp struct {
+		Long int64 `json:"long"`
+	}
+	err := fs.call(http.MethodPut, "RENEWDELEGATIONTOKEN", "/", url.Values{"token": {token}}, &resp)
+	if err != nil {
+		return time.Time{}, interpretException(err)
+	}
+
+	return time.Unix(0, resp.Long*int64(time.Millisecond)), nil
+}
+
+func (fs *webhdfs) CancelDelegationToken(token string) error {
+	err := fs.call(http.MethodPut, "CANCELDELEGATIONTOKEN", "/", url.Values{"token": {token}}, nil)
+	if err != nil {
+		return interpretException(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+func (fs *webhdfs) Usage(path string) (*extfs.ContentSummary, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		ContentSummary struct {
+			DirectoryCount int64 `json:"directoryCount"`
+			FileCount      int64 `json:"fileCount"`
+			Length         int64 `json:"length"`
+			SpaceConsumed  int64 `json:"spaceConsumed"`
+		} `json:"ContentSummary"`
+	}
+	err = fs.call(http.MethodGet, "GETCONTENTSUMMARY", fullpath, nil, &resp)
+	if err != nil {
+		return nil, &os.PathError{Op: "usage", Path: fullpath, Err: interpretException(err)}
+	}
+
+	cs := resp.ContentSummary
+	return &extfs.ContentSummary{
+		Length:         cs.Length,
+		FileCount:      cs.FileCount,
+		DirectoryCount: cs.DirectoryCount,
+		SpaceConsumed:  cs.SpaceConsumed,
+	}, nil
+}
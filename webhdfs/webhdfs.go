@@ -0,0 +1,505 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package webhdfs implements a filesystem over the WebHDFS REST API, served
+// by the namenodes and by the HttpFS gateways.
+package webhdfs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/user"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/util"
+)
+
+const (
+	defaultPort       = "9870"
+	defaultSecurePort = "9871"
+	pathPrefix        = "/webhdfs/v1"
+	maxRedirects      = 10
+)
+
+var errNoRedirect = errors.New("WebHDFS did not redirect the write to a datanode")
+
+// webhdfs is a filesystem based on the WebHDFS REST API.
+type webhdfs struct {
+	client   *http.Client
+	endpoint *url.URL
+	user     string
+	token    string
+	base     string
+}
+
+// New returns a WebHDFS filesystem. The endpoint is the address of the
+// namenode or of the HttpFS gateway, for example http://namenode:9870. The
+// requests are authenticated with the delegation token of the configuration,
+// or else with the user name.
+func New(endpoint, baseDir string, cfg *extfs.Config) (extfs.Filesystem, error) {
+	if cfg == nil {
+		cfg = &extfs.Config{}
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("Unsupported WebHDFS scheme %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, errors.New("WebHDFS endpoint has no host")
+	}
+	if u.Port() == "" {
+		port := defaultPort
+		if u.Scheme == "https" {
+			port = defaultSecurePort
+		}
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	name := cfg.User
+	if name == "" && cfg.DelegationToken == "" {
+		current, err := user.Current()
+		if err != nil {
+			name = "root"
+		} else {
+			name = current.Username
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       cfg.TLSConfig,
+		ResponseHeaderTimeout: cfg.RPCTimeout,
+	}
+	client := &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			// Only the reads follow the redirections to the datanodes, the
+			// writes send their data once the datanode is known.
+			if req.Method != http.MethodGet {
+				return http.ErrUseLastResponse
+			}
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+
+	return &webhdfs{
+		client:   client,
+		endpoint: &url.URL{Scheme: u.Scheme, Host: u.Host},
+		user:     name,
+		token:    cfg.DelegationToken,
+		base:     baseDir,
+	}, nil
+}
+
+func (fs *webhdfs) Create(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.createFile(filename, fullpath, true, 0)
+}
+
+func (fs *webhdfs) Open(filename string) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.openFile(filename, fullpath)
+}
+
+func (fs *webhdfs) OpenFile(filename string, flag int, perm os.FileMode) (extfs.File, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	accMode := flag & syscall.O_ACCMODE
+	if accMode == os.O_RDWR {
+		return nil, errors.New("WebHDFS file can only be opened as read-only or write-only")
+	}
+	if accMode == os.O_RDONLY {
+		return fs.openFile(filename, fullpath)
+	}
+
+	_, err = fs.stat(fullpath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	switch {
+	case exists && flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrExist}
+	case !exists && flag&os.O_CREATE == 0:
+		return nil, &os.PathError{Op: "open", Path: fullpath, Err: os.ErrNotExist}
+	case !exists || flag&os.O_TRUNC != 0:
+		return fs.createFile(filename, fullpath, flag&os.O_EXCL == 0, perm)
+	case flag&(os.O_APPEND|os.O_CREATE) != 0:
+		return fs.appendFile(filename, fullpath)
+	default:
+		return nil, errors.New("WebHDFS file can only be append written")
+	}
+}
+
+func (fs *webhdfs) Remove(filename string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return err
+	}
+
+	ok, err := fs.delete(fullpath, false)
+	if err == nil && !ok {
+		err = &os.PathError{Op: "remove", Path: fullpath, Err: os.ErrNotExist}
+	}
+
+	return err
+}
+
+func (fs *webhdfs) RemoveAll(path string) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	_, err = fs.delete(fullpath, true)
+	return err
+}
+
+func (fs *webhdfs) Rename(oldpath, newpath string) error {
+	var err error
+	oldpath, err = util.UnderlyingPath(fs.base, oldpath)
+	if err != nil {
+		return err
+	}
+
+	newpath, err = util.UnderlyingPath(fs.base, newpath)
+	if err != nil {
+		return err
+	}
+
+	// The OVERWRITE option replaces an existing destination, like
+	// os.Rename, instead of moving the source into it. The gateways which
+	// ignore it answer with a boolean.
+	params := url.Values{"destination": {newpath}, "renameoptions": {"OVERWRITE"}}
+	resp, err := fs.request(http.MethodPut, "RENAME", oldpath, params)
+	if err != nil {
+		return &os.PathError{Op: "rename", Path: oldpath, Err: interpretException(err)}
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Boolean *bool `json:"boolean"`
+	}
+	data, _ := ioutil.ReadAll(resp.Body)
+	if json.Unmarshal(data, &result) == nil && result.Boolean != nil && !*result.Boolean {
+		return &os.PathError{Op: "rename", Path: oldpath, Err: errors.New("WebHDFS rename failed")}
+	}
+
+	return nil
+}
+
+func (fs *webhdfs) Stat(filename string) (os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.stat(fullpath)
+}
+
+func (fs *webhdfs) ReadDir(path string) ([]os.FileInfo, error) {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		FileStatuses struct {
+			FileStatus []*FileStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	err = fs.call(http.MethodGet, "LISTSTATUS", fullpath, nil, &resp)
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: fullpath, Err: interpretException(err)}
+	}
+
+	fis := make([]os.FileInfo, 0, len(resp.FileStatuses.FileStatus))
+	for _, status := range resp.FileStatuses.FileStatus {
+		fis = append(fis, newFileInfo(status, fullpath))
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	return fis, nil
+}
+
+func (fs *webhdfs) MkdirAll(path string, perm os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, path)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Boolean bool `json:"boolean"`
+	}
+	err = fs.call(http.MethodPut, "MKDIRS", fullpath, url.Values{"permission": {permission(perm)}}, &resp)
+	if err == nil && !resp.Boolean {
+		err = errors.New("WebHDFS mkdirs failed")
+	}
+	if err != nil {
+		return &os.PathError{Op: "mkdir", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
+
+func (fs *webhdfs) Chmod(name string, mode os.FileMode) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	err = fs.call(http.MethodPut, "SETPERMISSION", fullpath, url.Values{"permission": {permission(mode)}}, nil)
+	if err != nil {
+		return &os.PathError{Op: "chmod", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
+
+func (fs *webhdfs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	fullpath, err := util.UnderlyingPath(fs.base, name)
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{
+		"accesstime":       {strconv.FormatInt(millis(atime), 10)},
+		"modificationtime": {strconv.FormatInt(millis(mtime), 10)},
+	}
+	err = fs.call(http.MethodPut, "SETTIMES", fullpath, params, nil)
+	if err != nil {
+		return &os.PathError{Op: "chtimes", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return nil
+}
+
+func (fs *webhdfs) Close() error {
+	fs.client.CloseIdleConnections()
+	return nil
+}
+
+func (fs *webhdfs) createFile(name, fullpath string, overwrite bool, perm os.FileMode) (extfs.File, error) {
+	params := url.Values{"overwrite": {strconv.FormatBool(overwrite)}}
+	if perm != 0 {
+		params.Set("permission", permission(perm))
+	}
+
+	location, err := fs.location(http.MethodPut, "CREATE", fullpath, params)
+	if err != nil {
+		return nil, &os.PathError{Op: "create", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return newWriter(fs, name, fullpath, http.MethodPut, location), nil
+}
+
+func (fs *webhdfs) appendFile(name, fullpath string) (extfs.File, error) {
+	location, err := fs.location(http.MethodPost, "APPEND", fullpath, nil)
+	if err != nil {
+		return nil, &os.PathError{Op: "append", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return newWriter(fs, name, fullpath, http.MethodPost, location), nil
+}
+
+func (fs *webhdfs) openFile(name, fullpath string) (extfs.File, error) {
+	fi, err := fs.stat(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newReader(fs, name, fullpath, fi.Size()), nil
+}
+
+func (fs *webhdfs) stat(fullpath string) (os.FileInfo, error) {
+	var resp struct {
+		FileStatus *FileStatus `json:"FileStatus"`
+	}
+	err := fs.call(http.MethodGet, "GETFILESTATUS", fullpath, nil, &resp)
+	if err == nil && resp.FileStatus == nil {
+		err = errors.New("WebHDFS returned no file status")
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return newFileInfo(resp.FileStatus, fullpath), nil
+}
+
+func (fs *webhdfs) delete(fullpath string, recursive bool) (bool, error) {
+	var resp struct {
+		Boolean bool `json:"boolean"`
+	}
+	err := fs.call(http.MethodDelete, "DELETE", fullpath, url.Values{"recursive": {strconv.FormatBool(recursive)}}, &resp)
+	if err != nil {
+		return false, &os.PathError{Op: "remove", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return resp.Boolean, nil
+}
+
+// open reads length bytes of a file from offset, or up to the end of the
+// file if length is negative. The namenode redirects the read to a datanode.
+func (fs *webhdfs) open(fullpath string, offset, length int64) (io.ReadCloser, error) {
+	params := url.Values{"offset": {strconv.FormatInt(offset, 10)}}
+	if length >= 0 {
+		params.Set("length", strconv.FormatInt(length, 10))
+	}
+
+	resp, err := fs.request(http.MethodGet, "OPEN", fullpath, params)
+	if err != nil {
+		return nil, &os.PathError{Op: "read", Path: fullpath, Err: interpretException(err)}
+	}
+
+	return resp.Body, nil
+}
+
+// url returns the URL of an operation on a path.
+func (fs *webhdfs) url(op, fullpath string, params url.Values) string {
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	query.Set("op", op)
+	if fs.token != "" {
+		query.Set("delegation", fs.token)
+	} else if fs.user != "" {
+		query.Set("user.name", fs.user)
+	}
+
+	u := *fs.endpoint
+	u.Path = pathPrefix + fullpath
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// request sends a request without a body. The response is a
+// *RemoteException if its status is not a success.
+func (fs *webhdfs) request(method, op, fullpath string, params url.Values) (*http.Response, error) {
+	req, err := http.NewRequest(method, fs.url(op, fullpath, params), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		return nil, readRemoteException(resp)
+	}
+
+	return resp, nil
+}
+
+// call sends a request and decodes its JSON response into v, unless v is
+// nil.
+func (fs *webhdfs) call(method, op, fullpath string, params url.Values, v interface{}) error {
+	resp, err := fs.request(method, op, fullpath, params)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if v == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return err
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// location sends the first request of a write, which the namenode or the
+// gateway redirects to where the data must be sent.
+func (fs *webhdfs) location(method, op, fullpath string, params url.Values) (string, error) {
+	resp, err := fs.request(method, op, fullpath, params)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	location := resp.Header.Get("Location")
+	if resp.StatusCode != http.StatusTemporaryRedirect || location == "" {
+		return "", errNoRedirect
+	}
+
+	u, err := resp.Request.URL.Parse(location)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+// upload sends the data of a write to the location returned by the first
+// request.
+func (fs *webhdfs) upload(method, location string, body io.Reader) error {
+	req, err := http.NewRequest(method, location, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := fs.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return readRemoteException(resp)
+	}
+	_, err = io.Copy(ioutil.Discard, resp.Body)
+	return err
+}
+
+// permission formats a mode as the octal permission of WebHDFS.
+func permission(mode os.FileMode) string {
+	perm := uint32(mode.Perm())
+	if mode&os.ModeSticky != 0 {
+		perm |= 01000
+	}
+
+	return strconv.FormatUint(uint64(perm), 8)
+}
+
+func millis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
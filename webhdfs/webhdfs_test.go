@@ -0,0 +1,234 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhdfs
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+	"github.com/rkcloudchain/extfs/extfstest"
+	"github.com/rkcloudchain/extfs/webhdfs/webhdfstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var server *webhdfstest.Server
+
+func TestMain(m *testing.M) {
+	extfstest.Main(m, func() (func(), error) {
+		var err error
+		server, err = webhdfstest.NewServer()
+		if err != nil {
+			return nil, err
+		}
+		return func() { server.Close() }, nil
+	})
+}
+
+func newFilesystem(t *testing.T, base string) extfs.Filesystem {
+	fs, err := New(server.URL(), base, &extfs.Config{User: "alice"})
+	require.NoError(t, err)
+
+	return fs
+}
+
+func TestFilesystem(t *testing.T) {
+	extfstest.Test(t, extfstest.Config{New: newFilesystem, Append: true})
+}
+
+func TestCreate(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test1")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+	fi, err := fs.Stat("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, int64(extfs.DefaultBlockSize), fi.Sys().(*FileStatus).BlockSize)
+}
+
+func TestOpenFile(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test3")
+	defer fs.Close()
+
+	f, err := fs.OpenFile("myfile.txt", os.O_WRONLY|os.O_CREATE, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("x"), 0)
+	assert.Equal(t, extfs.ErrUnsupported, err)
+	_, err = f.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	fi, err := fs.Stat("myfile.txt")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode())
+
+	_, err = fs.OpenFile("myfile.txt", os.O_RDWR, 0644)
+	assert.Error(t, err)
+
+	_, err = fs.OpenFile("myfile.txt", os.O_WRONLY, 0644)
+	assert.Error(t, err)
+}
+
+func TestRedirect(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test4")
+	defer fs.Close()
+
+	requests := server.DatanodeRequests()
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+	assert.Equal(t, requests+1, server.DatanodeRequests())
+
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "myfile.txt")))
+	assert.Equal(t, requests+2, server.DatanodeRequests())
+}
+
+func TestDirectories(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test5")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "a/b/file.txt", []byte("Hello"))
+
+	err := fs.Remove("a")
+	assert.True(t, errors.Is(err, syscall.ENOTEMPTY))
+
+	require.NoError(t, fs.Chmod("a", 0700|os.ModeSticky))
+	fi, err := fs.Stat("a")
+	require.NoError(t, err)
+	assert.Equal(t, os.ModeDir|os.ModeSticky|0700, fi.Mode())
+
+	mtime := time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, fs.Chtimes("a/b/file.txt", mtime, mtime))
+	fi, err = fs.Stat("a/b/file.txt")
+	require.NoError(t, err)
+	assert.True(t, mtime.Equal(fi.ModTime()))
+}
+
+func TestDelegationToken(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test6")
+	defer fs.Close()
+
+	tm, ok := fs.(TokenManager)
+	require.True(t, ok)
+
+	token, err := tm.GetDelegationToken("alice")
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	expiration, err := tm.RenewDelegationToken(token)
+	require.NoError(t, err)
+	assert.True(t, expiration.After(time.Now()))
+
+	tfs, err := New(server.URL(), "/cloudchain/test6", &extfs.Config{DelegationToken: token})
+	require.NoError(t, err)
+	defer tfs.Close()
+
+	extfstest.WriteFile(t, tfs, "myfile.txt", []byte("Hello world"))
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, tfs, "myfile.txt")))
+
+	require.NoError(t, tm.CancelDelegationToken(token))
+
+	_, err = tfs.Stat("myfile.txt")
+	assert.True(t, os.IsPermission(err))
+}
+
+func TestUsage(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test7")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "a/file1.txt", []byte("Hello"))
+	extfstest.WriteFile(t, fs, "a/b/file2.txt", []byte("world"))
+
+	cs, err := fs.(extfs.DiskUsage).Usage("a")
+	require.NoError(t, err)
+	assert.Equal(t, &extfs.ContentSummary{Length: 10, FileCount: 2, DirectoryCount: 2, SpaceConsumed: 10}, cs)
+}
+
+func TestChecksum(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test8")
+	defer fs.Close()
+
+	data := bytes.Repeat([]byte("Hello world"), 1000)
+	extfstest.WriteFile(t, fs, "myfile.txt", data)
+
+	for _, algorithm := range []extfs.ChecksumAlgorithm{extfs.MD5MD5CRC32C, extfs.SHA256, extfs.CRC32C} {
+		expected, err := extfs.ComputeChecksum(bytes.NewReader(data), algorithm, 0)
+		require.NoError(t, err)
+
+		cs, err := fs.(extfs.Checksummer).Checksum("myfile.txt", algorithm)
+		require.NoError(t, err)
+		assert.Equal(t, expected, cs)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	fs := newFilesystem(t, "/cloudchain/test9")
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "target.txt", []byte("Hello"))
+	extfstest.WriteFile(t, fs, "src1.txt", []byte(" world"))
+	extfstest.WriteFile(t, fs, "src2.txt", []byte("!"))
+
+	err := fs.(extfs.Concatenator).Concat("target.txt", []string{"src1.txt", "src1.txt"})
+	assert.Error(t, err)
+
+	require.NoError(t, fs.(extfs.Concatenator).Concat("target.txt", []string{"src1.txt", "src2.txt"}))
+	assert.Equal(t, "Hello world!", string(extfstest.ReadFile(t, fs, "target.txt")))
+	_, err = fs.Stat("src1.txt")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestTLS(t *testing.T) {
+	tlsServer, err := webhdfstest.NewTLSServer()
+	require.NoError(t, err)
+	defer tlsServer.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(tlsServer.Certificate())
+	fs, err := New(tlsServer.URL(), "/cloudchain", &extfs.Config{User: "alice", TLSConfig: &tls.Config{RootCAs: pool}})
+	require.NoError(t, err)
+	defer fs.Close()
+
+	extfstest.WriteFile(t, fs, "myfile.txt", []byte("Hello world"))
+	assert.Equal(t, "Hello world", string(extfstest.ReadFile(t, fs, "myfile.txt")))
+
+	untrusted, err := New(tlsServer.URL(), "/cloudchain", &extfs.Config{User: "alice"})
+	require.NoError(t, err)
+	defer untrusted.Close()
+
+	_, err = untrusted.Stat("myfile.txt")
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("ftp://localhost", "/", nil)
+	assert.Error(t, err)
+
+	fs, err := New("http://localhost", "/", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:9870", fs.(*webhdfs).endpoint.Host)
+
+	fs, err = New("https://localhost", "/", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:9871", fs.(*webhdfs).endpoint.Host)
+}
+
+func TestInterpretException(t *testing.T) {
+	assert.Equal(t, os.ErrNotExist, interpretException(&RemoteException{Exception: "FileNotFoundException"}))
+	assert.Equal(t, os.ErrPermission, interpretException(&RemoteException{Exception: "org.apache.hadoop.security.AccessControlException"}))
+	assert.Equal(t, syscall.ENOTEMPTY, interpretException(&RemoteException{Exception: "PathIsNotEmptyDirectoryException"}))
+	assert.Equal(t, extfs.ErrUnsupported, interpretException(&RemoteException{Exception: "UnsupportedOperationException"}))
+
+	err := &RemoteException{Exception: "IOException", Message: "failed"}
+	assert.Equal(t, err, interpretException(err))
+	assert.Equal(t, "IOException: failed", err.Error())
+}
@@ -0,0 +1,660 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package webhdfstest provides an in-process WebHDFS server for the tests.
+// The namenode answers the metadata operations and redirects the reads and
+// the writes to a datanode, like a real cluster. The files are stored in a
+// temporary directory.
+package webhdfstest
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rkcloudchain/extfs"
+)
+
+const (
+	pathPrefix = "/webhdfs/v1"
+	owner      = "webhdfs"
+	superGroup = "supergroup"
+
+	bytesPerCRC = 512
+)
+
+// Server is an in-process WebHDFS namenode and datanode.
+type Server struct {
+	namenode *httptest.Server
+	datanode *httptest.Server
+	root     string
+
+	mu       sync.Mutex
+	tokens   map[string]string // the users of the delegation tokens
+	requests int               // the requests served by the datanode
+}
+
+// NewServer starts a WebHDFS server over HTTP.
+func NewServer() (*Server, error) {
+	return newServer(httptest.NewServer)
+}
+
+// NewTLSServer starts a WebHDFS server over HTTPS. Its certificate is
+// returned by Certificate.
+func NewTLSServer() (*Server, error) {
+	return newServer(httptest.NewTLSServer)
+}
+
+func newServer(start func(http.Handler) *httptest.Server) (*Server, error) {
+	root, err := ioutil.TempDir("", "webhdfstest")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{root: root, tokens: make(map[string]string)}
+	s.namenode = start(http.HandlerFunc(s.serveNamenode))
+	s.datanode = start(http.HandlerFunc(s.serveDatanode))
+
+	return s, nil
+}
+
+// URL returns the URL of the namenode, such as http://127.0.0.1:50070.
+func (s *Server) URL() string {
+	return s.namenode.URL
+}
+
+// Certificate returns the certificate of a TLS server.
+func (s *Server) Certificate() *x509.Certificate {
+	return s.namenode.Certificate()
+}
+
+// DatanodeRequests returns the number of requests redirected to the
+// datanode so far.
+func (s *Server) DatanodeRequests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// Close stops the server and removes its files.
+func (s *Server) Close() error {
+	s.namenode.Close()
+	s.datanode.Close()
+
+	return os.RemoveAll(s.root)
+}
+
+type remoteException struct {
+	Exception     string `json:"exception"`
+	JavaClassName string `json:"javaClassName"`
+	Message       string `json:"message"`
+}
+
+type fileStatus struct {
+	AccessTime       int64  `json:"accessTime"`
+	BlockSize        int64  `json:"blockSize"`
+	Group            string `json:"group"`
+	Length           int64  `json:"length"`
+	ModificationTime int64  `json:"modificationTime"`
+	Owner            string `json:"owner"`
+	PathSuffix       string `json:"pathSuffix"`
+	Permission       string `json:"permission"`
+	Replication      int    `json:"replication"`
+	Type             string `json:"type"`
+}
+
+// request is a WebHDFS request. The path is the absolute path in the
+// namespace and local its location in the temporary directory.
+type request struct {
+	op    string
+	path  string
+	local string
+	query url.Values
+	user  string
+}
+
+func (s *Server) parse(w http.ResponseWriter, r *http.Request) (*request, bool) {
+	if !strings.HasPrefix(r.URL.Path, pathPrefix) {
+		http.NotFound(w, r)
+		return nil, false
+	}
+
+	p := path.Clean("/" + strings.TrimPrefix(r.URL.Path, pathPrefix))
+	query := r.URL.Query()
+	return &request{
+		op:    strings.ToUpper(query.Get("op")),
+		path:  p,
+		local: filepath.Join(s.root, filepath.FromSlash(p)),
+		query: query,
+	}, true
+}
+
+// authenticate finds the user of a request from its delegation token or its
+// user name.
+func (s *Server) authenticate(req *request) error {
+	if token := req.query.Get("delegation"); token != "" {
+		s.mu.Lock()
+		user, ok := s.tokens[token]
+		s.mu.Unlock()
+		if !ok {
+			return &exception{http.StatusForbidden, remoteException{
+				"InvalidToken", "org.apache.hadoop.security.token.SecretManager$InvalidToken",
+				"token can't be found in cache"}}
+		}
+		req.user = user
+		return nil
+	}
+
+	req.user = req.query.Get("user.name")
+	if req.user == "" {
+		return &exception{http.StatusUnauthorized, remoteException{
+			"SecurityException", "java.lang.SecurityException", "Failed to obtain user group information"}}
+	}
+
+	return nil
+}
+
+func (s *Server) serveNamenode(w http.ResponseWriter, r *http.Request) {
+	req, ok := s.parse(w, r)
+	if !ok {
+		return
+	}
+	if err := s.authenticate(req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var resp interface{}
+	var err error
+	switch r.Method + " " + req.op {
+	case "GET GETFILESTATUS":
+		resp, err = s.getFileStatus(req)
+	case "GET LISTSTATUS":
+		resp, err = s.listStatus(req)
+	case "GET GETCONTENTSUMMARY":
+		resp, err = s.getContentSummary(req)
+	case "GET OPEN", "GET GETFILECHECKSUM", "POST APPEND":
+		resp, err = s.redirect(r, req, false)
+	case "PUT CREATE":
+		resp, err = s.redirect(r, req, req.query.Get("overwrite") == "false")
+	case "PUT MKDIRS":
+		resp, err = s.mkdirs(req)
+	case "PUT RENAME":
+		err = s.rename(req)
+	case "DELETE DELETE":
+		resp, err = s.delete(req)
+	case "PUT SETPERMISSION":
+		err = s.setPermission(req)
+	case "PUT SETTIMES":
+		err = s.setTimes(req)
+	case "POST CONCAT":
+		err = s.concat(req)
+	case "GET GETDELEGATIONTOKEN":
+		resp, err = s.getDelegationToken(req)
+	case "PUT RENEWDELEGATIONTOKEN":
+		resp, err = s.renewDelegationToken(req)
+	case "PUT CANCELDELEGATIONTOKEN":
+		err = s.cancelDelegationToken(req)
+	default:
+		err = &exception{http.StatusBadRequest, remoteException{
+			"IllegalArgumentException", "java.lang.IllegalArgumentException",
+			fmt.Sprintf("Invalid value for webhdfs parameter \"op\": %s %s", r.Method, req.op)}}
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	switch resp := resp.(type) {
+	case nil:
+		w.WriteHeader(http.StatusOK)
+	case redirection:
+		http.Redirect(w, r, string(resp), http.StatusTemporaryRedirect)
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// redirection is the datanode URL of a data operation.
+type redirection string
+
+// redirect checks the file of a data operation and redirects it to the
+// datanode.
+func (s *Server) redirect(r *http.Request, req *request, exclusive bool) (interface{}, error) {
+	fi, err := os.Stat(req.local)
+	switch {
+	case req.op == "CREATE" && os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	case fi.IsDir():
+		return nil, &exception{http.StatusBadRequest, remoteException{
+			"FileNotFoundException", "java.io.FileNotFoundException", req.path + " is a directory"}}
+	case exclusive:
+		return nil, os.ErrExist
+	}
+
+	return redirection(s.datanode.URL + r.URL.Path + "?" + r.URL.RawQuery), nil
+}
+
+func (s *Server) serveDatanode(w http.ResponseWriter, r *http.Request) {
+	req, ok := s.parse(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	s.requests++
+	s.mu.Unlock()
+
+	var err error
+	switch r.Method + " " + req.op {
+	case "GET OPEN":
+		err = s.open(w, req)
+	case "GET GETFILECHECKSUM":
+		err = s.getFileChecksum(w, req)
+	case "PUT CREATE":
+		err = s.write(w, r, req, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	case "POST APPEND":
+		err = s.write(w, r, req, os.O_WRONLY|os.O_APPEND)
+	default:
+		err = &exception{http.StatusBadRequest, remoteException{
+			"IllegalArgumentException", "java.lang.IllegalArgumentException", "Invalid datanode operation " + req.op}}
+	}
+
+	if err != nil {
+		writeError(w, err)
+	}
+}
+
+func (s *Server) status(fi os.FileInfo, suffix string) *fileStatus {
+	mode := fi.Mode()
+	perm := uint32(mode.Perm())
+	if mode&os.ModeSticky != 0 {
+		perm |= 01000
+	}
+
+	status := &fileStatus{
+		AccessTime:       fi.ModTime().UnixNano() / int64(time.Millisecond),
+		Group:            superGroup,
+		ModificationTime: fi.ModTime().UnixNano() / int64(time.Millisecond),
+		Owner:            owner,
+		PathSuffix:       suffix,
+		Permission:       strconv.FormatUint(uint64(perm), 8),
+		Type:             "DIRECTORY",
+	}
+	if !fi.IsDir() {
+		status.Type = "FILE"
+		status.Length = fi.Size()
+		status.BlockSize = extfs.DefaultBlockSize
+		status.Replication = 1
+	}
+
+	return status
+}
+
+func (s *Server) getFileStatus(req *request) (interface{}, error) {
+	fi, err := os.Stat(req.local)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"FileStatus": s.status(fi, "")}, nil
+}
+
+func (s *Server) listStatus(req *request) (interface{}, error) {
+	fi, err := os.Stat(req.local)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []*fileStatus{}
+	if fi.IsDir() {
+		fis, err := ioutil.ReadDir(req.local)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range fis {
+			statuses = append(statuses, s.status(child, child.Name()))
+		}
+	} else {
+		statuses = append(statuses, s.status(fi, ""))
+	}
+
+	return map[string]interface{}{"FileStatuses": map[string]interface{}{"FileStatus": statuses}}, nil
+}
+
+func (s *Server) getContentSummary(req *request) (interface{}, error) {
+	var length, files, dirs int64
+	err := filepath.Walk(req.local, func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			dirs++
+		} else {
+			files++
+			length += fi.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"ContentSummary": map[string]interface{}{
+		"directoryCount": dirs,
+		"fileCount":      files,
+		"length":         length,
+		"quota":          -1,
+		"spaceConsumed":  length,
+		"spaceQuota":     -1,
+	}}, nil
+}
+
+func (s *Server) mkdirs(req *request) (interface{}, error) {
+	perm, err := permission(req.query.Get("permission"), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(req.local, perm); err != nil {
+		return nil, err
+	}
+
+	return map[string]bool{"boolean": true}, nil
+}
+
+func (s *Server) rename(req *request) error {
+	dst := req.query.Get("destination")
+	if !path.IsAbs(dst) {
+		return os.ErrInvalid
+	}
+	if _, err := os.Stat(req.local); err != nil {
+		return err
+	}
+
+	return os.Rename(req.local, filepath.Join(s.root, filepath.FromSlash(path.Clean(dst))))
+}
+
+func (s *Server) delete(req *request) (interface{}, error) {
+	if _, err := os.Lstat(req.local); os.IsNotExist(err) {
+		return map[string]bool{"boolean": false}, nil
+	}
+	if req.path == "/" {
+		return nil, os.ErrPermission
+	}
+
+	var err error
+	if req.query.Get("recursive") == "true" {
+		err = os.RemoveAll(req.local)
+	} else {
+		err = os.Remove(req.local)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]bool{"boolean": true}, nil
+}
+
+func (s *Server) setPermission(req *request) error {
+	perm, err := permission(req.query.Get("permission"), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.Chmod(req.local, perm)
+}
+
+func (s *Server) setTimes(req *request) error {
+	fi, err := os.Stat(req.local)
+	if err != nil {
+		return err
+	}
+
+	mtime := fi.ModTime()
+	atime := mtime
+	if ms, err := strconv.ParseInt(req.query.Get("modificationtime"), 10, 64); err == nil && ms >= 0 {
+		mtime = time.Unix(0, ms*int64(time.Millisecond))
+	}
+	if ms, err := strconv.ParseInt(req.query.Get("accesstime"), 10, 64); err == nil && ms >= 0 {
+		atime = time.Unix(0, ms*int64(time.Millisecond))
+	}
+
+	return os.Chtimes(req.local, atime, mtime)
+}
+
+func (s *Server) concat(req *request) error {
+	target, err := os.OpenFile(req.local, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	for _, src := range strings.Split(req.query.Get("sources"), ",") {
+		local := filepath.Join(s.root, filepath.FromSlash(path.Clean(src)))
+		data, err := ioutil.ReadFile(local)
+		if err != nil {
+			return err
+		}
+		if _, err := target.Write(data); err != nil {
+			return err
+		}
+		if err := os.Remove(local); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) getDelegationToken(req *request) (interface{}, error) {
+	if req.query.Get("delegation") != "" {
+		return nil, &exception{http.StatusForbidden, remoteException{
+			"IOException", "java.io.IOException",
+			"Delegation Token can be issued only with kerberos or web authentication"}}
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	s.mu.Lock()
+	s.tokens[token] = req.user
+	s.mu.Unlock()
+
+	return map[string]interface{}{"Token": map[string]string{"urlString": token}}, nil
+}
+
+func (s *Server) renewDelegationToken(req *request) (interface{}, error) {
+	s.mu.Lock()
+	_, ok := s.tokens[req.query.Get("token")]
+	s.mu.Unlock()
+	if !ok {
+		return nil, &exception{http.StatusForbidden, remoteException{
+			"InvalidToken", "org.apache.hadoop.security.token.SecretManager$InvalidToken", "token can't be found in cache"}}
+	}
+
+	expiration := time.Now().Add(24*time.Hour).UnixNano() / int64(time.Millisecond)
+	return map[string]int64{"long": expiration}, nil
+}
+
+func (s *Server) cancelDelegationToken(req *request) error {
+	token := req.query.Get("token")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tokens[token]; !ok {
+		return &exception{http.StatusForbidden, remoteException{
+			"InvalidToken", "org.apache.hadoop.security.token.SecretManager$InvalidToken", "token can't be found in cache"}}
+	}
+	delete(s.tokens, token)
+
+	return nil
+}
+
+func (s *Server) open(w http.ResponseWriter, req *request) error {
+	f, err := os.Open(req.local)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	offset, _ := strconv.ParseInt(req.query.Get("offset"), 10, 64)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	var r io.Reader = f
+	if length, err := strconv.ParseInt(req.query.Get("length"), 10, 64); err == nil {
+		r = io.LimitReader(f, length)
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// getFileChecksum returns the MD5MD5CRC32C checksum of a file, serialized
+// like the one of HDFS.
+func (s *Server) getFileChecksum(w http.ResponseWriter, req *request) error {
+	f, err := os.Open(req.local)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	checksum, err := extfs.ComputeChecksum(f, extfs.MD5MD5CRC32C, extfs.DefaultBlockSize)
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, 12, 28)
+	binary.BigEndian.PutUint32(data, bytesPerCRC)
+	binary.BigEndian.PutUint64(data[4:], extfs.DefaultBlockSize/bytesPerCRC)
+	data = append(data, checksum.Sum...)
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"FileChecksum": map[string]interface{}{
+		"algorithm": "MD5-of-262144MD5-of-512CRC32C",
+		"bytes":     hex.EncodeToString(data),
+		"length":    md5.Size + 12,
+	}})
+	return nil
+}
+
+func (s *Server) write(w http.ResponseWriter, r *http.Request, req *request, flag int) error {
+	perm, err := permission(req.query.Get("permission"), 0644)
+	if err != nil {
+		return err
+	}
+
+	// The parents of a created file are created as well.
+	if flag&os.O_CREATE != 0 {
+		if err := os.MkdirAll(filepath.Dir(req.local), 0755); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(req.local, flag, perm)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, r.Body)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	if req.op == "CREATE" {
+		w.Header().Set("Location", "hdfs://"+r.Host+req.path)
+		w.WriteHeader(http.StatusCreated)
+		return nil
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// permission parses an octal WebHDFS permission.
+func permission(s string, def os.FileMode) (os.FileMode, error) {
+	if s == "" {
+		return def, nil
+	}
+
+	perm, err := strconv.ParseUint(s, 8, 32)
+	if err != nil || perm > 01777 {
+		return 0, os.ErrInvalid
+	}
+
+	mode := os.FileMode(perm) & os.ModePerm
+	if perm&01000 != 0 {
+		mode |= os.ModeSticky
+	}
+
+	return mode, nil
+}
+
+// exception is an error returned with its HTTP status.
+type exception struct {
+	status int
+	remote remoteException
+}
+
+func (e *exception) Error() string {
+	return e.remote.Exception + ": " + e.remote.Message
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var e *exception
+	if !errors.As(err, &e) {
+		e = &exception{http.StatusInternalServerError, remoteException{"IOException", "java.io.IOException", err.Error()}}
+		// ENOTEMPTY is checked first since it also matches os.ErrExist.
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			e = &exception{http.StatusNotFound, remoteException{"FileNotFoundException", "java.io.FileNotFoundException", err.Error()}}
+		case errors.Is(err, syscall.ENOTEMPTY):
+			e = &exception{http.StatusForbidden, remoteException{"PathIsNotEmptyDirectoryException", "org.apache.hadoop.fs.PathIsNotEmptyDirectoryException", err.Error()}}
+		case errors.Is(err, os.ErrExist):
+			e = &exception{http.StatusForbidden, remoteException{"FileAlreadyExistsException", "org.apache.hadoop.fs.FileAlreadyExistsException", err.Error()}}
+		case errors.Is(err, os.ErrPermission):
+			e = &exception{http.StatusForbidden, remoteException{"AccessControlException", "org.apache.hadoop.security.AccessControlException", err.Error()}}
+		case errors.Is(err, os.ErrInvalid):
+			e = &exception{http.StatusBadRequest, remoteException{"IllegalArgumentException", "java.lang.IllegalArgumentException", err.Error()}}
+		}
+	}
+
+	writeJSON(w, e.status, map[string]interface{}{"RemoteException": e.remote})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
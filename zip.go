@@ -0,0 +1,123 @@
+/*
+Copyright RocKontrol Corp. 2019 All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package extfs
+
+import (
+	"archive/zip"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// maxSymlinkSize bounds the content of the zip entries read as the target
+// of a symbolic link.
+const maxSymlinkSize = 4096
+
+// ExportZip writes the tree under root to w as a zip archive, with the
+// modes and the modification times of the files and the symbolic links on
+// the filesystems which have them. The files are compressed with deflate.
+func ExportZip(fs Filesystem, root string, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	err := walkTree(fs, root, func(fullpath, name string, fi os.FileInfo, target string) error {
+		hdr, err := zip.FileInfoHeader(fi)
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+		switch {
+		case fi.IsDir():
+			hdr.Name += "/"
+		case target != "":
+			hdr.Method = zip.Store
+		default:
+			hdr.Method = zip.Deflate
+		}
+
+		fw, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return err
+		}
+		switch {
+		case fi.IsDir():
+			return nil
+		case target != "":
+			_, err = io.WriteString(fw, target)
+			return err
+		}
+
+		f, err := fs.Open(fullpath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(fw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// ImportZip writes the entries of the zip archive of the given size read
+// from r under root. The entries whose names or link targets go up out of
+// root are rejected with ErrCrossedBoundary.
+func ImportZip(fs Filesystem, root string, r io.ReaderAt, size int64) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+
+	im := newImporter(fs, root)
+	for _, zf := range zr.File {
+		e := &entry{
+			name:  zf.Name,
+			mode:  zf.Mode(),
+			mtime: zf.Modified,
+		}
+		switch {
+		case e.mode.IsDir() || strings.HasSuffix(zf.Name, "/"):
+			err = im.dir(e)
+		case e.mode&os.ModeSymlink != 0:
+			e.target, err = readZipFile(zf, maxSymlinkSize)
+			if err == nil {
+				err = im.symlink(e)
+			}
+		default:
+			err = importZipFile(im, e, zf)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return im.finish()
+}
+
+func importZipFile(im *importer, e *entry, zf *zip.File) error {
+	rc, err := zf.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return im.file(e, rc)
+}
+
+func readZipFile(zf *zip.File, limit int64) (string, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(rc, limit))
+	return string(data), err
+}